@@ -11,7 +11,15 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var entitiesSchemaFile, entitiesOutDir string
+var (
+	entitiesSchemaFile, entitiesOutDir, entitiesConfig string
+	entitiesJSONTag, entitiesNullable, entitiesDecimal string
+	entitiesRelations                                  string
+	entitiesTags                                       []string
+	entitiesTagTemplate                                string
+	entitiesIncludeIgnored, entitiesPackagePerSchema   bool
+	entitiesIDTypes                                    bool
+)
 
 // entitiesCmd represents the entities command
 var entitiesCmd = &cobra.Command{
@@ -19,23 +27,62 @@ var entitiesCmd = &cobra.Command{
 	Short: "Convert schema.prisma models to Go structs",
 	Long:  `Convert schema.prisma models to Go structs.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		outFile, err := usecase.PrismaToGoStructs(
+		tags := make([]usecase.TagProfile, 0, len(entitiesTags))
+		for _, tag := range entitiesTags {
+			tags = append(tags, usecase.TagProfile(tag))
+		}
+
+		outFiles, err := usecase.PrismaToGoStructs(
 			entitiesSchemaFile,
 			entitiesOutDir,
+			usecase.EntitiesOptions{
+				JSONTag:          usecase.JSONTagSource(entitiesJSONTag),
+				Nullable:         usecase.NullableMode(entitiesNullable),
+				Decimal:          usecase.DecimalMode(entitiesDecimal),
+				Tags:             tags,
+				TagTemplate:      entitiesTagTemplate,
+				Relations:        usecase.RelationsMode(entitiesRelations),
+				IDTypes:          entitiesIDTypes,
+				IncludeIgnored:   entitiesIncludeIgnored,
+				PackagePerSchema: entitiesPackagePerSchema,
+				ConfigPath:       entitiesConfig,
+			},
 		)
 		if err != nil {
 			fmt.Println("prisma-go-tools: ", err)
 			os.Exit(1)
 		}
 
-		fmt.Printf("prisma-go-tools entities: wrote %s\n", outFile)
+		for _, outFile := range outFiles {
+			fmt.Printf("prisma-go-tools entities: wrote %s\n", outFile)
+		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(entitiesCmd)
 	entitiesCmd.Flags().
-		StringVarP(&entitiesSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file")
+		StringVarP(&entitiesSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file, folder or glob pattern")
 	entitiesCmd.Flags().
 		StringVarP(&entitiesOutDir, "output", "o", "./models", "Output directory for Go entities structs")
+	entitiesCmd.Flags().
+		StringVarP(&entitiesConfig, "config", "c", "", "Path to the prisma-go-tools.yaml or .json config file (default: looked up in the working directory)")
+	entitiesCmd.Flags().
+		StringVar(&entitiesJSONTag, "json-tag", "", "Name used in json tags: \"field\" (Prisma field name), \"column\" (database column name), \"camel\" or \"snake\" (default \"field\")")
+	entitiesCmd.Flags().
+		StringVar(&entitiesNullable, "nullable", "", "Representation of optional fields: \"pointer\", \"sql\" (database/sql Null types), \"pgtype\" (pgx pgtype types) or \"generic\" (generated Null[T] wrapper) (default \"pointer\")")
+	entitiesCmd.Flags().
+		StringVar(&entitiesDecimal, "decimal", "", "Go type of Decimal fields: \"string\", \"shopspring\" (github.com/shopspring/decimal) or \"float64\" (default \"string\")")
+	entitiesCmd.Flags().
+		StringSliceVar(&entitiesTags, "tags", nil, "Comma-separated struct tag profiles: sqlx, pgx, json, bun, gorm, bson or validate (default sqlx,json, plus bson for MongoDB)")
+	entitiesCmd.Flags().
+		StringVar(&entitiesTagTemplate, "tag-template", "", "Go text/template rendering the struct tags of every field, e.g. '{{printf \"db:%q\" .Column}}', replacing --tags")
+	entitiesCmd.Flags().
+		StringVar(&entitiesRelations, "relations", "", "Generation of relations: \"none\", \"metadata\" (Relations method), \"fields\" (relation fields tagged db:\"-\") or \"aggregates\" (UserWithPosts structs) (default \"none\")")
+	entitiesCmd.Flags().
+		BoolVar(&entitiesIDTypes, "id-types", false, "Generate an ID type per model, e.g. UserID, for its primary key and the relation fields referencing it")
+	entitiesCmd.Flags().
+		BoolVar(&entitiesIncludeIgnored, "include-ignored", false, "Also generate models, views and fields marked with @@ignore or @ignore")
+	entitiesCmd.Flags().
+		BoolVar(&entitiesPackagePerSchema, "package-per-schema", false, "Generate the entities of every database schema (@@schema) in their own package")
 }
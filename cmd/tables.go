@@ -11,7 +11,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var tablesSchemaFile, tablesOutDir string
+var (
+	tablesSchemaFile, tablesOutDir string
+	tablesConfig                   string
+	tablesIncludeIgnored           bool
+	tablesPackagePerSchema         bool
+)
 
 // tablesCmd represents the tables command
 var tablesCmd = &cobra.Command{
@@ -19,23 +24,36 @@ var tablesCmd = &cobra.Command{
 	Short: "Convert schema.prisma tables to a Go custom type",
 	Long:  `Convert schema.prisma tables to a Go custom type`,
 	Run: func(cmd *cobra.Command, args []string) {
-		outFile, err := usecase.PrismaToSQLTables(
+		outFiles, err := usecase.PrismaToSQLTables(
 			tablesSchemaFile,
 			tablesOutDir,
+			usecase.TablesOptions{
+				IncludeIgnored:   tablesIncludeIgnored,
+				PackagePerSchema: tablesPackagePerSchema,
+				ConfigPath:       tablesConfig,
+			},
 		)
 		if err != nil {
 			fmt.Println("prisma-go-tools: ", err)
 			os.Exit(1)
 		}
 
-		fmt.Printf("prisma-go-tools tables: wrote %s\n", outFile)
+		for _, outFile := range outFiles {
+			fmt.Printf("prisma-go-tools tables: wrote %s\n", outFile)
+		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(tablesCmd)
 	tablesCmd.Flags().
-		StringVarP(&tablesSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file")
+		StringVarP(&tablesSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file, folder or glob pattern")
 	tablesCmd.Flags().
 		StringVarP(&tablesOutDir, "output", "o", "./tables", "Output directory for Go Table custom type")
+	tablesCmd.Flags().
+		StringVarP(&tablesConfig, "config", "c", "", "Path to the prisma-go-tools.yaml or .json config file, whose entities section sets the Go types of the columns (default: looked up in the working directory)")
+	tablesCmd.Flags().
+		BoolVar(&tablesIncludeIgnored, "include-ignored", false, "Also generate helpers for models, views and fields marked with @@ignore or @ignore")
+	tablesCmd.Flags().
+		BoolVar(&tablesPackagePerSchema, "package-per-schema", false, "Generate the tables of every database schema (@@schema) in their own package")
 }
@@ -11,7 +11,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var triggersSchemaFile string
+var (
+	triggersSchemaFile     string
+	triggersIncludeIgnored bool
+)
 
 // entitiesCmd represents the triggers command
 var triggersCmd = &cobra.Command{
@@ -19,7 +22,10 @@ var triggersCmd = &cobra.Command{
 	Short: "Create PostgreSQL updated at triggers from schema.prisma files",
 	Long:  `Create PostgreSQL updated at triggers from schema.prisma files.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		outsFiles, err := usecase.CreateUpdatedAtTriggers(triggersSchemaFile)
+		outsFiles, err := usecase.CreateUpdatedAtTriggers(
+			triggersSchemaFile,
+			triggersIncludeIgnored,
+		)
 		if err != nil {
 			fmt.Println("prisma-go-tools: ", err)
 			os.Exit(1)
@@ -34,5 +40,7 @@ var triggersCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(triggersCmd)
 	triggersCmd.Flags().
-		StringVarP(&triggersSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file")
+		StringVarP(&triggersSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file, folder or glob pattern")
+	triggersCmd.Flags().
+		BoolVar(&triggersIncludeIgnored, "include-ignored", false, "Also create triggers for models and fields marked with @@ignore or @ignore")
 }
@@ -29,5 +29,5 @@ var unzipCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(unzipCmd)
 	unzipCmd.Flags().
-		StringVarP(&unzipSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file")
+		StringVarP(&unzipSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file, folder or glob pattern")
 }
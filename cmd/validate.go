@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+var validateSchemaFile, validateConfig string
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate schema.prisma and report problems with their positions",
+	Long:  `Validate schema.prisma and report problems with their positions.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		diags, err := usecase.ValidateSchema(validateSchemaFile, validateConfig)
+		if err != nil {
+			fmt.Println("prisma-go-tools: ", err)
+			os.Exit(1)
+		}
+
+		for _, diag := range diags {
+			fmt.Println(diag)
+		}
+
+		if diags.HasErrors() {
+			os.Exit(1)
+		}
+
+		fmt.Printf("prisma-go-tools validate: schema is valid\n")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+	validateCmd.Flags().
+		StringVarP(&validateSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file, folder or glob pattern")
+	validateCmd.Flags().
+		StringVarP(&validateConfig, "config", "c", "", "Path to the prisma-go-tools.yaml or .json config file (default: looked up in the working directory)")
+}
@@ -29,5 +29,5 @@ var zipCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(zipCmd)
 	zipCmd.Flags().
-		StringVarP(&zipSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file")
+		StringVarP(&zipSchemaFile, "schema", "s", "./schema.prisma", "Path to the Prisma schema file, folder or glob pattern")
 }
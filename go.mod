@@ -5,6 +5,7 @@ go 1.23.3
 require (
 	github.com/ettle/strcase v0.2.0
 	github.com/spf13/cobra v1.9.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
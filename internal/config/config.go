@@ -0,0 +1,209 @@
+// Package config reads the prisma-go-tools configuration file.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileNames are the configuration files looked up in the working directory
+// when no path is given, in order of precedence.
+var FileNames = []string{
+	"prisma-go-tools.yaml",
+	"prisma-go-tools.yml",
+	"prisma-go-tools.json",
+}
+
+// Config is the content of a prisma-go-tools configuration file.
+type Config struct {
+	// Path is the file the configuration was read from, empty when no file
+	// was found.
+	Path string `yaml:"-" json:"-"`
+	// Naming configures the Go identifiers generated from the schema.
+	Naming Naming `yaml:"naming" json:"naming"`
+	// Entities sets the defaults of the entities command flags.
+	Entities Entities `yaml:"entities" json:"entities"`
+	// Types overrides the Go types of the generated entities.
+	Types []TypeOverride `yaml:"types" json:"types"`
+}
+
+// Entities configures the generated entities. Every setting is overridden by
+// the matching command flag.
+type Entities struct {
+	// Tags lists the struct tag profiles, e.g. [sqlx, json, validate].
+	Tags []string `yaml:"tags" json:"tags"`
+	// TagTemplate is a text/template rendering the struct tags of every
+	// field, replacing Tags.
+	TagTemplate string `yaml:"tag_template" json:"tag_template"`
+	// JSONTag is what json tags are named after: field, column, camel or
+	// snake.
+	JSONTag string `yaml:"json_tag" json:"json_tag"`
+	// Nullable is the representation of optional fields.
+	Nullable string `yaml:"nullable" json:"nullable"`
+	// Decimal is the Go type of Decimal fields.
+	Decimal string `yaml:"decimal" json:"decimal"`
+	// Relations is how relations are generated: none, metadata, fields or
+	// aggregates.
+	Relations string `yaml:"relations" json:"relations"`
+	// IDTypes generates an ID type per model.
+	IDTypes bool `yaml:"id_types" json:"id_types"`
+}
+
+// Naming configures how Prisma names are converted to Go identifiers.
+type Naming struct {
+	// Initialisms are upper cased in Go identifiers, in addition to the
+	// golint ones (ID, URL, API, UUID, ...). An initialism prefixed with a
+	// dash, e.g. -API, is removed from the list.
+	Initialisms []string `yaml:"initialisms" json:"initialisms"`
+}
+
+// TypeOverride maps a Prisma scalar, a native type or a single model field to
+// a Go type. Exactly one of Scalar, Native or Field selects the fields it
+// applies to, except that Scalar may narrow a Native override down to the
+// fields of one scalar type.
+type TypeOverride struct {
+	// Scalar is a Prisma scalar type, e.g. Decimal.
+	Scalar string `yaml:"scalar" json:"scalar"`
+	// Native is a native type attribute, e.g. Uuid or @db.Uuid.
+	Native string `yaml:"native" json:"native"`
+	// Field is a model field path, e.g. Order.metadata.
+	Field string `yaml:"field" json:"field"`
+	// Type is the Go type, either qualified by its package name, e.g.
+	// orders.Metadata, or by its full import path, e.g.
+	// github.com/shopspring/decimal.Decimal.
+	Type string `yaml:"type" json:"type"`
+	// Nullable is the Go type of optional fields, replacing the one picked
+	// by the nullable mode.
+	Nullable string `yaml:"nullable" json:"nullable"`
+	// Import is the import path of the package Type is qualified with.
+	Import string `yaml:"import" json:"import"`
+	// Alias is the name the package is imported as.
+	Alias string `yaml:"alias" json:"alias"`
+}
+
+// NativeName returns the native type without the @db. prefix.
+func (o TypeOverride) NativeName() string {
+	return strings.TrimPrefix(strings.TrimPrefix(o.Native, "@"), "db.")
+}
+
+// FieldPath splits Field into its model and field names.
+func (o TypeOverride) FieldPath() (model, field string) {
+	model, field, _ = strings.Cut(o.Field, ".")
+	return model, field
+}
+
+// Load reads the configuration file at path. When path is empty, the first of
+// FileNames found in the working directory is read, and an empty
+// configuration is returned when there is none.
+func Load(path string) (*Config, error) {
+	if path == "" {
+		for _, name := range FileNames {
+			if _, err := os.Stat(name); err == nil {
+				path = name
+				break
+			} else if !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+		}
+		if path == "" {
+			return &Config{}, nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config: %w", err)
+	}
+
+	cfg, err := decode(path, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	cfg.Path = path
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+func decode(path string, data []byte) (*Config, error) {
+	cfg := &Config{}
+
+	if filepath.Ext(path) == ".json" {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(cfg); err != nil {
+			return nil, err
+		}
+		return cfg, nil
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	if len(c.Entities.Tags) > 0 && c.Entities.TagTemplate != "" {
+		return errors.New("entities: tags cannot be combined with tag_template")
+	}
+	for i, initialism := range c.Naming.Initialisms {
+		name := strings.TrimPrefix(initialism, "-")
+		if name == "" || strings.ContainsFunc(name, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			return fmt.Errorf("naming: initialisms[%d]: %q must only contain letters and digits", i, initialism)
+		}
+	}
+	for i, override := range c.Types {
+		if err := override.validate(); err != nil {
+			return fmt.Errorf("types[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (o TypeOverride) validate() error {
+	switch {
+	case o.Field != "" && (o.Scalar != "" || o.Native != ""):
+		return errors.New("field cannot be combined with scalar or native")
+	case o.Field == "" && o.Scalar == "" && o.Native == "":
+		return errors.New("one of scalar, native or field is required")
+	}
+
+	if o.Field != "" {
+		model, field := o.FieldPath()
+		if model == "" || field == "" || strings.Contains(field, ".") {
+			return fmt.Errorf("field %q must be a Model.field path", o.Field)
+		}
+	}
+
+	if o.Native != "" && o.NativeName() == "" {
+		return fmt.Errorf("native %q must name a native type, e.g. @db.Uuid", o.Native)
+	}
+
+	if o.Type == "" {
+		return errors.New("type is required")
+	}
+
+	if o.Alias != "" && o.Import == "" {
+		return errors.New("alias requires import")
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package schema
+
+import (
+	gotoken "go/token"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Annotation is a `/// @go.<name>(<arg>)` line of a doc comment, which
+// controls the Go code generated for the documented element.
+type Annotation struct {
+	// Name is the annotation name without the @, e.g. "go.name".
+	Name string
+	// Arg is the text between the parentheses, empty when there are none.
+	Arg string
+}
+
+// annotationTarget is the kind of element an annotation documents.
+type annotationTarget int
+
+const (
+	annotateModel annotationTarget = iota
+	annotateField
+	annotateEnum
+)
+
+// annotationSpec describes where an annotation is allowed and whether it
+// takes an argument.
+type annotationSpec struct {
+	arg      bool
+	targets  []annotationTarget
+	multiple bool
+	check    func(arg string) string
+}
+
+var tagRegex = regexp.MustCompile(`^[A-Za-z_][\w-]*:"(?:[^"\\]|\\.)*"(?: +[A-Za-z_][\w-]*:"(?:[^"\\]|\\.)*")*$`)
+
+// annotationSpecs lists the supported annotations.
+var annotationSpecs = map[string]annotationSpec{
+	"go.name": {
+		arg:     true,
+		targets: []annotationTarget{annotateModel, annotateField},
+		check:   checkGoIdentifier,
+	},
+	"go.type": {arg: true, targets: []annotationTarget{annotateField}},
+	"go.tag": {
+		arg:      true,
+		targets:  []annotationTarget{annotateField},
+		multiple: true,
+		check:    checkStructTag,
+	},
+	"go.omit": {targets: []annotationTarget{annotateField}},
+}
+
+func checkGoIdentifier(arg string) string {
+	if !gotoken.IsIdentifier(arg) || gotoken.IsKeyword(arg) {
+		return "must be a valid Go identifier"
+	}
+	return ""
+}
+
+func checkStructTag(arg string) string {
+	if !tagRegex.MatchString(arg) {
+		return `must be struct tags like key:"value"`
+	}
+	return ""
+}
+
+// IsAnnotation reports whether a doc comment line is a @go.* annotation.
+func IsAnnotation(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), "@go.")
+}
+
+// Annotations returns the @go.* annotations of a doc comment, in order.
+func Annotations(doc string) []Annotation {
+	var annotations []Annotation
+	for _, line := range strings.Split(doc, "\n") {
+		if !IsAnnotation(line) {
+			continue
+		}
+		line = strings.TrimPrefix(strings.TrimSpace(line), "@")
+
+		name, arg, hasArg := strings.Cut(line, "(")
+		if hasArg {
+			arg = strings.TrimSuffix(strings.TrimSpace(arg), ")")
+		}
+		annotations = append(annotations, Annotation{
+			Name: strings.TrimSpace(name),
+			Arg:  strings.TrimSpace(arg),
+		})
+	}
+	return annotations
+}
+
+// findAnnotation returns the last annotation with the given name in doc.
+func findAnnotation(doc, name string) (Annotation, bool) {
+	var found Annotation
+	ok := false
+	for _, annotation := range Annotations(doc) {
+		if annotation.Name == name {
+			found, ok = annotation, true
+		}
+	}
+	return found, ok
+}
+
+// Annotation returns the @go.* annotation of the model with the given name,
+// e.g. "go.name".
+func (m *Model) Annotation(name string) (Annotation, bool) {
+	return findAnnotation(m.Doc, name)
+}
+
+// Annotation returns the @go.* annotation of the field with the given name,
+// e.g. "go.type".
+func (f *Field) Annotation(name string) (Annotation, bool) {
+	return findAnnotation(f.Doc, name)
+}
+
+// Tags returns the arguments of the @go.tag annotations of the field.
+func (f *Field) Tags() []string {
+	var tags []string
+	for _, annotation := range Annotations(f.Doc) {
+		if annotation.Name == "go.tag" {
+			tags = append(tags, annotation.Arg)
+		}
+	}
+	return tags
+}
+
+// checkAnnotations reports unknown, misplaced, duplicate and malformed
+// annotations in the doc comment of an element.
+func (v *validator) checkAnnotations(
+	doc, element string,
+	pos Position,
+	target annotationTarget,
+) {
+	seen := map[string]bool{}
+	for _, annotation := range Annotations(doc) {
+		spec, ok := annotationSpecs[annotation.Name]
+		switch {
+		case !ok:
+			v.diags.Errorf(pos, "unknown annotation @%s on %s", annotation.Name, element)
+			continue
+		case !slices.Contains(spec.targets, target):
+			v.diags.Errorf(pos, "annotation @%s is not allowed on %s", annotation.Name, element)
+			continue
+		case seen[annotation.Name] && !spec.multiple:
+			v.diags.Errorf(pos, "duplicate annotation @%s on %s", annotation.Name, element)
+			continue
+		}
+		seen[annotation.Name] = true
+
+		if spec.arg != (annotation.Arg != "") {
+			if spec.arg {
+				v.diags.Errorf(pos, "annotation @%s on %s expects an argument", annotation.Name, element)
+			} else {
+				v.diags.Errorf(pos, "annotation @%s on %s takes no argument", annotation.Name, element)
+			}
+			continue
+		}
+		if spec.check != nil {
+			if problem := spec.check(annotation.Arg); problem != "" {
+				v.diags.Errorf(
+					pos,
+					"argument %s of annotation @%s on %s %s",
+					annotation.Arg,
+					annotation.Name,
+					element,
+					problem,
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnnotations(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want []Annotation
+	}{
+		{
+			name: "no annotations",
+			doc:  "A user.\nSecond line.",
+		},
+		{
+			name: "annotations among doc lines",
+			doc:  "The id.\n@go.name(UserID)\n  @go.omit  \nMore docs.",
+			want: []Annotation{
+				{Name: "go.name", Arg: "UserID"},
+				{Name: "go.omit"},
+			},
+		},
+		{
+			name: "arguments with parentheses and quotes",
+			doc:  `@go.type(func() error)` + "\n" + `@go.tag(validate:"required,max=10")`,
+			want: []Annotation{
+				{Name: "go.type", Arg: "func() error"},
+				{Name: "go.tag", Arg: `validate:"required,max=10"`},
+			},
+		},
+		{
+			name: "other @ lines are not annotations",
+			doc:  "@deprecated use email\nsee @go.name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Annotations(tt.doc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Annotations() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldAnnotations(t *testing.T) {
+	field := mustParse(t, `model M {
+  /// @go.name(First)
+  /// @go.name(Second)
+  /// @go.tag(validate:"required")
+  /// @go.tag(form:"a" query:"a")
+  a String
+}`).Model("M").Field("a")
+
+	name, ok := field.Annotation("go.name")
+	if !ok || name.Arg != "Second" {
+		t.Errorf(`Annotation("go.name") = %+v, %t, want Second, true`, name, ok)
+	}
+	if _, ok := field.Annotation("go.omit"); ok {
+		t.Error(`Annotation("go.omit") found, want none`)
+	}
+
+	want := []string{`validate:"required"`, `form:"a" query:"a"`}
+	if got := field.Tags(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Tags() = %q, want %q", got, want)
+	}
+}
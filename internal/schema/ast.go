@@ -0,0 +1,530 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Schema is the parsed representation of a Prisma schema.
+type Schema struct {
+	// Path is the file, folder or glob pattern the schema was loaded from.
+	Path string
+	// Files lists every schema file that was parsed.
+	Files []string
+
+	Datasources []*ConfigBlock
+	Generators  []*ConfigBlock
+	Models      []*Model
+	Views       []*Model
+	Types       []*Model
+	Enums       []*Enum
+}
+
+// BlockKind tells apart the block kinds that share the Model layout.
+type BlockKind string
+
+const (
+	ModelBlock BlockKind = "model"
+	ViewBlock  BlockKind = "view"
+	TypeBlock  BlockKind = "type"
+)
+
+// ConfigBlock represents a datasource or generator block.
+type ConfigBlock struct {
+	Kind       string
+	Name       string
+	Properties []*Property
+	Doc        string
+	Pos        Position
+}
+
+// Property represents a `key = value` line inside a config block.
+type Property struct {
+	Name  string
+	Value Expr
+	Pos   Position
+}
+
+// Model represents a model, view or composite type block.
+type Model struct {
+	Kind       BlockKind
+	Name       string
+	Fields     []*Field
+	Attributes []*Attribute
+	Doc        string
+	Pos        Position
+}
+
+// Field represents a single field of a model, view or composite type.
+type Field struct {
+	Name       string
+	Type       FieldType
+	Attributes []*Attribute
+	Doc        string
+	Pos        Position
+}
+
+// FieldType is the type reference of a field, e.g. `String?` or `Post[]`.
+type FieldType struct {
+	Name     string
+	List     bool
+	Optional bool
+	// Unsupported holds the argument of `Unsupported("...")` types.
+	Unsupported string
+	Pos         Position
+}
+
+// Enum represents an enum block.
+type Enum struct {
+	Name       string
+	Values     []*EnumValue
+	Attributes []*Attribute
+	Doc        string
+	Pos        Position
+}
+
+// EnumValue represents a single value of an enum.
+type EnumValue struct {
+	Name       string
+	Attributes []*Attribute
+	Doc        string
+	Pos        Position
+}
+
+// Attribute represents a field (`@id`) or block (`@@map("users")`)
+// attribute. Name does not include the leading `@`/`@@`, e.g. "db.Uuid".
+type Attribute struct {
+	Name string
+	Args []*Argument
+	Pos  Position
+}
+
+// Argument is a positional or named argument of an attribute or function.
+type Argument struct {
+	Name  string
+	Value Expr
+	Pos   Position
+}
+
+// Expr is a value expression: string, number, identifier, function call or
+// array.
+type Expr interface {
+	Position() Position
+	String() string
+}
+
+// StringLit is a string literal with its unescaped value.
+type StringLit struct {
+	Value string
+	Pos   Position
+}
+
+// NumberLit is a numeric literal as written in the source.
+type NumberLit struct {
+	Value string
+	Pos   Position
+}
+
+// Ident is a bare identifier such as `true`, `Cascade` or `title`.
+type Ident struct {
+	Name string
+	Pos  Position
+}
+
+// FuncCall is a function call such as `now()` or `env("DATABASE_URL")`.
+type FuncCall struct {
+	Name string
+	Args []*Argument
+	Pos  Position
+}
+
+// ArrayLit is a list of expressions such as `[id, email]`.
+type ArrayLit struct {
+	Elems []Expr
+	Pos   Position
+}
+
+func (e *StringLit) Position() Position { return e.Pos }
+func (e *NumberLit) Position() Position { return e.Pos }
+func (e *Ident) Position() Position     { return e.Pos }
+func (e *FuncCall) Position() Position  { return e.Pos }
+func (e *ArrayLit) Position() Position  { return e.Pos }
+
+func (e *StringLit) String() string { return fmt.Sprintf("%q", e.Value) }
+func (e *NumberLit) String() string { return e.Value }
+func (e *Ident) String() string     { return e.Name }
+
+func (e *FuncCall) String() string {
+	return fmt.Sprintf("%s(%s)", e.Name, argumentsString(e.Args))
+}
+
+func (e *ArrayLit) String() string {
+	elems := make([]string, 0, len(e.Elems))
+	for _, elem := range e.Elems {
+		elems = append(elems, elem.String())
+	}
+	return fmt.Sprintf("[%s]", strings.Join(elems, ", "))
+}
+
+func (a *Argument) String() string {
+	if a.Name == "" {
+		return a.Value.String()
+	}
+	return fmt.Sprintf("%s: %s", a.Name, a.Value)
+}
+
+func argumentsString(args []*Argument) string {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		parts = append(parts, arg.String())
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (a *Attribute) String() string {
+	if len(a.Args) == 0 {
+		return a.Name
+	}
+	return fmt.Sprintf("%s(%s)", a.Name, argumentsString(a.Args))
+}
+
+// Arg returns the argument with the given name, falling back to the
+// positional argument at index when no named argument matches.
+func (a *Attribute) Arg(index int, name string) *Argument {
+	return findArgument(a.Args, index, name)
+}
+
+// Arg returns the argument with the given name, falling back to the
+// positional argument at index when no named argument matches.
+func (e *FuncCall) Arg(index int, name string) *Argument {
+	return findArgument(e.Args, index, name)
+}
+
+func findArgument(args []*Argument, index int, name string) *Argument {
+	if name != "" {
+		for _, arg := range args {
+			if arg.Name == name {
+				return arg
+			}
+		}
+	}
+
+	positional := 0
+	for _, arg := range args {
+		if arg.Name != "" {
+			continue
+		}
+		if positional == index {
+			return arg
+		}
+		positional++
+	}
+
+	return nil
+}
+
+// StringValue returns the value of e when it is a string literal.
+func StringValue(e Expr) (string, bool) {
+	if s, ok := e.(*StringLit); ok {
+		return s.Value, true
+	}
+	return "", false
+}
+
+// findAttribute returns the first attribute with the given name.
+func findAttribute(attrs []*Attribute, name string) *Attribute {
+	for _, attr := range attrs {
+		if attr.Name == name {
+			return attr
+		}
+	}
+	return nil
+}
+
+// mappedName returns the string argument of the `map` attribute, if any.
+func mappedName(attrs []*Attribute) (string, bool) {
+	return stringAttribute(attrs, "map")
+}
+
+// stringAttribute returns the first string argument of the attribute with
+// the given name, if any.
+func stringAttribute(attrs []*Attribute, name string) (string, bool) {
+	attr := findAttribute(attrs, name)
+	if attr == nil {
+		return "", false
+	}
+	arg := attr.Arg(0, "name")
+	if arg == nil {
+		return "", false
+	}
+	return StringValue(arg.Value)
+}
+
+// Attribute returns the first block attribute with the given name.
+func (m *Model) Attribute(name string) *Attribute {
+	return findAttribute(m.Attributes, name)
+}
+
+// Field returns the field with the given name.
+func (m *Model) Field(name string) *Field {
+	for _, field := range m.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// DBName returns the physical table name: the `@@map` argument if present,
+// otherwise the model name.
+func (m *Model) DBName() string {
+	if name, ok := mappedName(m.Attributes); ok {
+		return name
+	}
+	return m.Name
+}
+
+// SchemaName returns the database schema of the model or view: the
+// `@@schema` argument, or an empty string when it has none.
+func (m *Model) SchemaName() string {
+	name, _ := stringAttribute(m.Attributes, "schema")
+	return name
+}
+
+// Ignored reports whether the model or view is marked with `@@ignore`.
+func (m *Model) Ignored() bool {
+	return m.Attribute("ignore") != nil
+}
+
+// Attribute returns the first field attribute with the given name.
+func (f *Field) Attribute(name string) *Attribute {
+	return findAttribute(f.Attributes, name)
+}
+
+// HasAttribute reports whether the field carries the given attribute.
+func (f *Field) HasAttribute(name string) bool {
+	return f.Attribute(name) != nil
+}
+
+// Ignored reports whether the field is marked with `@ignore`.
+func (f *Field) Ignored() bool {
+	return f.HasAttribute("ignore")
+}
+
+// DBName returns the physical column name: the `@map` argument if present,
+// otherwise the field name.
+func (f *Field) DBName() string {
+	if name, ok := mappedName(f.Attributes); ok {
+		return name
+	}
+	return f.Name
+}
+
+// NativeType returns the `@db.*` attribute of the field, if any.
+func (f *Field) NativeType() *Attribute {
+	for _, attr := range f.Attributes {
+		if strings.HasPrefix(attr.Name, "db.") {
+			return attr
+		}
+	}
+	return nil
+}
+
+func (t FieldType) String() string {
+	name := t.Name
+	if t.Unsupported != "" {
+		name = fmt.Sprintf("Unsupported(%q)", t.Unsupported)
+	}
+	if t.List {
+		name += "[]"
+	}
+	if t.Optional {
+		name += "?"
+	}
+	return name
+}
+
+// Attribute returns the first block attribute with the given name.
+func (e *Enum) Attribute(name string) *Attribute {
+	return findAttribute(e.Attributes, name)
+}
+
+// DBName returns the database enum name: the `@@map` argument if present,
+// otherwise the enum name.
+func (e *Enum) DBName() string {
+	if name, ok := mappedName(e.Attributes); ok {
+		return name
+	}
+	return e.Name
+}
+
+// SchemaName returns the database schema of the enum: the `@@schema`
+// argument, or an empty string when it has none.
+func (e *Enum) SchemaName() string {
+	name, _ := stringAttribute(e.Attributes, "schema")
+	return name
+}
+
+// Attribute returns the first attribute of the value with the given name.
+func (v *EnumValue) Attribute(name string) *Attribute {
+	return findAttribute(v.Attributes, name)
+}
+
+// DBName returns the database value: the `@map` argument if present,
+// otherwise the value name.
+func (v *EnumValue) DBName() string {
+	if name, ok := mappedName(v.Attributes); ok {
+		return name
+	}
+	return v.Name
+}
+
+// Property returns the property with the given name.
+func (b *ConfigBlock) Property(name string) *Property {
+	for _, prop := range b.Properties {
+		if prop.Name == name {
+			return prop
+		}
+	}
+	return nil
+}
+
+// Provider returns the provider of the datasource block, e.g. "postgresql"
+// or "mysql", or an empty string when the schema has no datasource. The
+// "postgres" alias is returned as "postgresql".
+func (s *Schema) Provider() string {
+	if len(s.Datasources) == 0 {
+		return ""
+	}
+	prop := s.Datasources[0].Property("provider")
+	if prop == nil {
+		return ""
+	}
+	provider, _ := StringValue(prop.Value)
+	if provider == "postgres" {
+		return "postgresql"
+	}
+	return provider
+}
+
+// DatabaseSchemas returns the database schemas listed in the `schemas`
+// property of the datasource block, used by the multiSchema feature.
+func (s *Schema) DatabaseSchemas() []string {
+	if len(s.Datasources) == 0 {
+		return nil
+	}
+	prop := s.Datasources[0].Property("schemas")
+	if prop == nil {
+		return nil
+	}
+	array, ok := prop.Value.(*ArrayLit)
+	if !ok {
+		return nil
+	}
+	var schemas []string
+	for _, elem := range array.Elems {
+		if name, ok := StringValue(elem); ok {
+			schemas = append(schemas, name)
+		}
+	}
+	return schemas
+}
+
+// Model returns the model with the given name.
+func (s *Schema) Model(name string) *Model {
+	for _, model := range s.Models {
+		if model.Name == name {
+			return model
+		}
+	}
+	return nil
+}
+
+// Enum returns the enum with the given name.
+func (s *Schema) Enum(name string) *Enum {
+	for _, enum := range s.Enums {
+		if enum.Name == name {
+			return enum
+		}
+	}
+	return nil
+}
+
+// View returns the view with the given name.
+func (s *Schema) View(name string) *Model {
+	for _, view := range s.Views {
+		if view.Name == name {
+			return view
+		}
+	}
+	return nil
+}
+
+// Type returns the composite type with the given name.
+func (s *Schema) Type(name string) *Model {
+	for _, typ := range s.Types {
+		if typ.Name == name {
+			return typ
+		}
+	}
+	return nil
+}
+
+// WithoutIgnored returns a copy of the schema without the models and views
+// marked with `@@ignore` and the fields marked with `@ignore`. The schema
+// itself is not modified.
+func (s *Schema) WithoutIgnored() *Schema {
+	filter := func(blocks []*Model) []*Model {
+		kept := make([]*Model, 0, len(blocks))
+		for _, block := range blocks {
+			if block.Ignored() {
+				continue
+			}
+			copied := *block
+			copied.Fields = make([]*Field, 0, len(block.Fields))
+			for _, field := range block.Fields {
+				if !field.Ignored() {
+					copied.Fields = append(copied.Fields, field)
+				}
+			}
+			kept = append(kept, &copied)
+		}
+		return kept
+	}
+
+	copied := *s
+	copied.Models = filter(s.Models)
+	copied.Views = filter(s.Views)
+	copied.Types = filter(s.Types)
+	return &copied
+}
+
+// InDatabaseSchema returns a copy of the schema with only the models, views
+// and enums of the given database schema, an empty name selecting the ones
+// without `@@schema`. Composite types have no schema, they are only kept
+// with the latter.
+func (s *Schema) InDatabaseSchema(name string) *Schema {
+	filter := func(blocks []*Model) []*Model {
+		var kept []*Model
+		for _, block := range blocks {
+			if block.SchemaName() == name {
+				kept = append(kept, block)
+			}
+		}
+		return kept
+	}
+
+	copied := *s
+	copied.Models = filter(s.Models)
+	copied.Views = filter(s.Views)
+	if name != "" {
+		copied.Types = nil
+	}
+	copied.Enums = nil
+	for _, enum := range s.Enums {
+		if enum.SchemaName() == name {
+			copied.Enums = append(copied.Enums, enum)
+		}
+	}
+	return &copied
+}
@@ -0,0 +1,32 @@
+package schema
+
+import "testing"
+
+func TestSchemaProvider(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{`datasource db {
+  provider = "postgresql"
+}`, "postgresql"},
+		{`datasource db {
+  provider = "postgres"
+}`, "postgresql"},
+		{`datasource db {
+  provider = "mysql"
+}`, "mysql"},
+		{`datasource db {
+  url = env("DATABASE_URL")
+}`, ""},
+		{`model User {
+  id Int @id
+}`, ""},
+	}
+
+	for _, tt := range tests {
+		if got := mustParse(t, tt.src).Provider(); got != tt.want {
+			t.Errorf("Provider() of\n%s\n= %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
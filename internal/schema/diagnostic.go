@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Severity tells apart diagnostics that make a schema unusable from the ones
+// that are only worth a look.
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Diagnostic is a problem found in a schema, reported with its position.
+type Diagnostic struct {
+	Pos      Position
+	Severity Severity
+	Message  string
+}
+
+func (d *Diagnostic) Error() string {
+	return fmt.Sprintf("%s: %s: %s", d.Pos, d.Severity, d.Message)
+}
+
+func errorf(pos Position, format string, args ...any) *Diagnostic {
+	return &Diagnostic{
+		Pos:      pos,
+		Severity: SeverityError,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+func warningf(pos Position, format string, args ...any) *Diagnostic {
+	return &Diagnostic{
+		Pos:      pos,
+		Severity: SeverityWarning,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// Diagnostics is a list of diagnostics. It implements error so generators can
+// fail with every problem found at once.
+type Diagnostics []*Diagnostic
+
+// Errorf appends an error diagnostic at pos.
+func (d *Diagnostics) Errorf(pos Position, format string, args ...any) {
+	*d = append(*d, errorf(pos, format, args...))
+}
+
+// Warningf appends a warning diagnostic at pos.
+func (d *Diagnostics) Warningf(pos Position, format string, args ...any) {
+	*d = append(*d, warningf(pos, format, args...))
+}
+
+// HasErrors reports whether any diagnostic has error severity.
+func (d Diagnostics) HasErrors() bool {
+	for _, diag := range d {
+		if diag.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors returns only the diagnostics with error severity.
+func (d Diagnostics) Errors() Diagnostics {
+	var errs Diagnostics
+	for _, diag := range d {
+		if diag.Severity == SeverityError {
+			errs = append(errs, diag)
+		}
+	}
+	return errs
+}
+
+// Sorted returns the diagnostics ordered by file and position.
+func (d Diagnostics) Sorted() Diagnostics {
+	sorted := slices.Clone(d)
+	slices.SortStableFunc(sorted, func(a, b *Diagnostic) int {
+		return cmp.Or(
+			cmp.Compare(a.Pos.Filename, b.Pos.Filename),
+			cmp.Compare(a.Pos.Offset, b.Pos.Offset),
+		)
+	})
+	return sorted
+}
+
+func (d Diagnostics) Error() string {
+	lines := make([]string, 0, len(d))
+	for _, diag := range d {
+		lines = append(lines, diag.Error())
+	}
+	return strings.Join(lines, "\n")
+}
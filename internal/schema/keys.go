@@ -0,0 +1,131 @@
+package schema
+
+// Key is the primary key, a unique constraint or an index of a model, set
+// with @id, @unique, @@id, @@unique or @@index.
+type Key struct {
+	// Fields are the fields of the key, in order. Unknown fields are left
+	// out, they are reported by Validate.
+	Fields []*Field
+	// Name is the name argument of @@id and @@unique, used by the Prisma
+	// Client.
+	Name string
+	// Map is the name of the constraint or index in the database, if set.
+	Map string
+	Pos Position
+}
+
+// Columns returns the database column names of the key fields.
+func (k *Key) Columns() []string {
+	columns := make([]string, 0, len(k.Fields))
+	for _, field := range k.Fields {
+		columns = append(columns, field.DBName())
+	}
+	return columns
+}
+
+// Has reports whether field is part of the key.
+func (k *Key) Has(field *Field) bool {
+	for _, keyField := range k.Fields {
+		if keyField == field {
+			return true
+		}
+	}
+	return false
+}
+
+// PrimaryKey returns the primary key of the model, set with @id on a field or
+// @@id on the model, or nil when it has none.
+func (m *Model) PrimaryKey() *Key {
+	for _, field := range m.Fields {
+		if attr := field.Attribute("id"); attr != nil {
+			return fieldKey(field, attr)
+		}
+	}
+	if attr := m.Attribute("id"); attr != nil {
+		return m.blockKey(attr)
+	}
+	return nil
+}
+
+// UniqueKeys returns the unique constraints of the model, set with @unique
+// on fields, in field order, then with @@unique.
+func (m *Model) UniqueKeys() []*Key {
+	var keys []*Key
+	for _, field := range m.Fields {
+		if attr := field.Attribute("unique"); attr != nil {
+			keys = append(keys, fieldKey(field, attr))
+		}
+	}
+	for _, attr := range m.Attributes {
+		if attr.Name == "unique" {
+			keys = append(keys, m.blockKey(attr))
+		}
+	}
+	return keys
+}
+
+// Indexes returns the indexes of the model, set with @@index.
+func (m *Model) Indexes() []*Key {
+	var keys []*Key
+	for _, attr := range m.Attributes {
+		if attr.Name == "index" {
+			keys = append(keys, m.blockKey(attr))
+		}
+	}
+	return keys
+}
+
+func fieldKey(field *Field, attr *Attribute) *Key {
+	key := &Key{Fields: []*Field{field}, Pos: attr.Pos}
+	if arg := attr.Arg(-1, "map"); arg != nil {
+		key.Map, _ = StringValue(arg.Value)
+	}
+	return key
+}
+
+func (m *Model) blockKey(attr *Attribute) *Key {
+	key := &Key{Pos: attr.Pos}
+	for _, ref := range keyFieldRefs(attr) {
+		if field := m.Field(ref.name); field != nil {
+			key.Fields = append(key.Fields, field)
+		}
+	}
+	if arg := attr.Arg(-1, "name"); arg != nil {
+		key.Name, _ = StringValue(arg.Value)
+	}
+	if arg := attr.Arg(-1, "map"); arg != nil {
+		key.Map, _ = StringValue(arg.Value)
+	}
+	return key
+}
+
+// keyFieldRef is a field listed in @@id, @@unique or @@index, e.g. title in
+// @@index([title(sort: Desc)]).
+type keyFieldRef struct {
+	name string
+	pos  Position
+}
+
+// keyFieldRefs returns the fields listed in the fields argument of a block
+// key attribute.
+func keyFieldRefs(attr *Attribute) []keyFieldRef {
+	arg := attr.Arg(0, "fields")
+	if arg == nil {
+		return nil
+	}
+	array, ok := arg.Value.(*ArrayLit)
+	if !ok {
+		return nil
+	}
+
+	refs := make([]keyFieldRef, 0, len(array.Elems))
+	for _, elem := range array.Elems {
+		switch e := elem.(type) {
+		case *Ident:
+			refs = append(refs, keyFieldRef{name: e.Name, pos: e.Pos})
+		case *FuncCall:
+			refs = append(refs, keyFieldRef{name: e.Name, pos: e.Pos})
+		}
+	}
+	return refs
+}
@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// keyString renders a key as columns, name and map, e.g. "[a b] name=n map=m".
+func keyString(key *Key) string {
+	if key == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%v name=%s map=%s", key.Columns(), key.Name, key.Map)
+}
+
+func keyStrings(keys []*Key) string {
+	var lines []string
+	for _, key := range keys {
+		lines = append(lines, keyString(key))
+	}
+	return strings.Join(lines, "; ")
+}
+
+func TestModelKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		primary string
+		unique  string
+		indexes string
+	}{
+		{
+			name: "field attributes",
+			src: `model M {
+  id    Int    @id @map("_id")
+  email String @unique(map: "m_email_key")
+  slug  String @unique
+}`,
+			primary: "[_id] name= map=",
+			unique:  "[email] name= map=m_email_key; [slug] name= map=",
+		},
+		{
+			name: "block attributes",
+			src: `model M {
+  a Int
+  b Int @map("b_col")
+  c Int
+
+  @@id([a, b], name: "ab", map: "m_pkey")
+  @@unique([b, c])
+  @@index([c(sort: Desc), a], map: "m_c_a_idx")
+  @@index([a])
+}`,
+			primary: "[a b_col] name=ab map=m_pkey",
+			unique:  "[b_col c] name= map=",
+			indexes: "[c a] name= map=m_c_a_idx; [a] name= map=",
+		},
+		{
+			name: "unknown fields are left out",
+			src: `model M {
+  a Int
+
+  @@id([a, missing])
+}`,
+			primary: "[a] name= map=",
+		},
+		{
+			name: "no keys",
+			src: `model M {
+  a Int
+}`,
+			primary: "<nil>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := mustParse(t, tt.src).Model("M")
+			if got := keyString(model.PrimaryKey()); got != tt.primary {
+				t.Errorf("PrimaryKey() = %s, want %s", got, tt.primary)
+			}
+			if got := keyStrings(model.UniqueKeys()); got != tt.unique {
+				t.Errorf("UniqueKeys() = %s, want %s", got, tt.unique)
+			}
+			if got := keyStrings(model.Indexes()); got != tt.indexes {
+				t.Errorf("Indexes() = %s, want %s", got, tt.indexes)
+			}
+		})
+	}
+}
+
+func TestKeyHas(t *testing.T) {
+	model := mustParse(t, `model M {
+  a Int
+  b Int
+  c Int
+
+  @@id([a, b])
+}`).Model("M")
+	key := model.PrimaryKey()
+
+	for name, want := range map[string]bool{"a": true, "b": true, "c": false} {
+		if got := key.Has(model.Field(name)); got != want {
+			t.Errorf("Has(%s) = %t, want %t", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package schema
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var punctuation = map[rune]tokenKind{
+	'{': tokenLBrace,
+	'}': tokenRBrace,
+	'(': tokenLParen,
+	')': tokenRParen,
+	'[': tokenLBracket,
+	']': tokenRBracket,
+	',': tokenComma,
+	':': tokenColon,
+	'=': tokenEqual,
+	'?': tokenQuestion,
+	'.': tokenDot,
+}
+
+// lexer splits a Prisma schema source into tokens. Regular `//` comments are
+// dropped, `///` doc comments are kept so they can be attached to the AST.
+type lexer struct {
+	filename string
+	src      []byte
+	offset   int
+	line     int
+	column   int
+	tokens   []token
+}
+
+func lex(filename string, src []byte) ([]token, error) {
+	l := &lexer{
+		filename: filename,
+		src:      src,
+		line:     1,
+		column:   1,
+	}
+
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		l.tokens = append(l.tokens, tok)
+		if tok.kind == tokenEOF {
+			return l.tokens, nil
+		}
+	}
+}
+
+func (l *lexer) pos() Position {
+	return Position{
+		Filename: l.filename,
+		Offset:   l.offset,
+		Line:     l.line,
+		Column:   l.column,
+	}
+}
+
+func (l *lexer) peek() rune {
+	if l.offset >= len(l.src) {
+		return -1
+	}
+	r, _ := utf8.DecodeRune(l.src[l.offset:])
+	return r
+}
+
+func (l *lexer) peekAt(n int) byte {
+	if l.offset+n >= len(l.src) {
+		return 0
+	}
+	return l.src[l.offset+n]
+}
+
+func (l *lexer) advance() rune {
+	r, size := utf8.DecodeRune(l.src[l.offset:])
+	l.offset += size
+	if r == '\n' {
+		l.line++
+		l.column = 1
+	} else {
+		l.column++
+	}
+	return r
+}
+
+func (l *lexer) next() (token, error) {
+	for {
+		r := l.peek()
+		if r == ' ' || r == '\t' || r == '\r' || r == '\uFEFF' {
+			l.advance()
+			continue
+		}
+		if r == '/' && l.peekAt(1) == '/' && l.peekAt(2) != '/' {
+			l.skipLine()
+			continue
+		}
+		break
+	}
+
+	pos := l.pos()
+	r := l.peek()
+
+	switch {
+	case r == -1:
+		return token{kind: tokenEOF, pos: pos}, nil
+	case r == '\n':
+		l.advance()
+		return token{kind: tokenNewline, text: "\n", pos: pos}, nil
+	case r == '/' && l.peekAt(1) == '/':
+		start := l.offset + 3
+		l.skipLine()
+		text := strings.TrimRight(string(l.src[start:l.offset]), "\r")
+		text = strings.TrimPrefix(text, " ")
+		return token{kind: tokenDocComment, text: text, pos: pos}, nil
+	case r == '"':
+		return l.lexString(pos)
+	case r == '-' || unicode.IsDigit(r):
+		return l.lexNumber(pos)
+	case r == '_' || unicode.IsLetter(r):
+		start := l.offset
+		for r := l.peek(); r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r); r = l.peek() {
+			l.advance()
+		}
+		return token{
+			kind: tokenIdent,
+			text: string(l.src[start:l.offset]),
+			pos:  pos,
+		}, nil
+	case r == '@':
+		l.advance()
+		if l.peek() == '@' {
+			l.advance()
+			return token{kind: tokenAtAt, text: "@@", pos: pos}, nil
+		}
+		return token{kind: tokenAt, text: "@", pos: pos}, nil
+	}
+
+	if kind, ok := punctuation[r]; ok {
+		l.advance()
+		return token{kind: kind, text: string(r), pos: pos}, nil
+	}
+
+	return token{}, errorf(pos, "unexpected character %q", r)
+}
+
+func (l *lexer) skipLine() {
+	for r := l.peek(); r != -1 && r != '\n'; r = l.peek() {
+		l.advance()
+	}
+}
+
+func (l *lexer) lexString(pos Position) (token, error) {
+	start := l.offset
+	l.advance()
+	for {
+		switch l.peek() {
+		case -1, '\n':
+			return token{}, errorf(pos, "unterminated string literal")
+		case '\\':
+			l.advance()
+			if l.peek() == -1 {
+				return token{}, errorf(pos, "unterminated string literal")
+			}
+			l.advance()
+			continue
+		case '"':
+			l.advance()
+			raw := string(l.src[start:l.offset])
+			value, err := strconv.Unquote(raw)
+			if err != nil {
+				return token{}, errorf(pos, "invalid string literal %s", raw)
+			}
+			return token{kind: tokenString, text: value, pos: pos}, nil
+		}
+		l.advance()
+	}
+}
+
+func (l *lexer) lexNumber(pos Position) (token, error) {
+	start := l.offset
+	if l.peek() == '-' {
+		l.advance()
+		if !unicode.IsDigit(l.peek()) {
+			return token{}, errorf(pos, "unexpected character '-'")
+		}
+	}
+	for r := l.peek(); unicode.IsDigit(r) || r == '.'; r = l.peek() {
+		l.advance()
+	}
+	return token{
+		kind: tokenNumber,
+		text: string(l.src[start:l.offset]),
+		pos:  pos,
+	}, nil
+}
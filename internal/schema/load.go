@@ -0,0 +1,119 @@
+package schema
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Load parses the schema at path, which can be a single schema file, a
+// folder of .prisma files (prismaSchemaFolder) or a glob pattern such as
+// `prisma/schema/*.prisma`. All files are merged into a single Schema, so
+// models and enums can reference each other across files.
+func Load(path string) (*Schema, error) {
+	files, err := schemaFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &Schema{Path: path}
+	for _, file := range files {
+		s, err := ParseFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		merged.Files = append(merged.Files, file)
+		merged.Datasources = append(merged.Datasources, s.Datasources...)
+		merged.Generators = append(merged.Generators, s.Generators...)
+		merged.Models = append(merged.Models, s.Models...)
+		merged.Views = append(merged.Views, s.Views...)
+		merged.Types = append(merged.Types, s.Types...)
+		merged.Enums = append(merged.Enums, s.Enums...)
+	}
+
+	return merged, nil
+}
+
+func isGlob(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+// schemaFiles lists the schema files referenced by path in a stable order.
+func schemaFiles(path string) ([]string, error) {
+	if isGlob(path) {
+		files, err := filepath.Glob(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema pattern %q: %w", path, err)
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no schema files match %q", path)
+		}
+		slices.Sort(files)
+		return files, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema file: %w", err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && filepath.Ext(p) == ".prisma" {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema folder: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no .prisma files found in %s", path)
+	}
+
+	return files, nil
+}
+
+// MigrationsDir locates the migrations folder the same way the Prisma CLI
+// does: next to the file that declares the datasource block. For
+// folder-based schemas the schema folder itself and its parent are also
+// considered, since earlier Prisma versions placed migrations there. When
+// none of the candidates exists the first one is returned.
+func (s *Schema) MigrationsDir() string {
+	var dirs []string
+	if len(s.Datasources) > 0 {
+		dirs = append(dirs, filepath.Dir(s.Datasources[0].Pos.Filename))
+	}
+
+	folder := isGlob(s.Path)
+	root := s.Path
+	if info, err := os.Stat(s.Path); err == nil && info.IsDir() {
+		folder = true
+	} else {
+		root = filepath.Dir(s.Path)
+	}
+
+	dirs = append(dirs, root)
+	if folder {
+		dirs = append(dirs, filepath.Dir(root))
+	}
+
+	for _, dir := range dirs {
+		migrationsDir := filepath.Join(dir, "migrations")
+		if info, err := os.Stat(migrationsDir); err == nil && info.IsDir() {
+			return migrationsDir
+		}
+	}
+
+	return filepath.Join(dirs[0], "migrations")
+}
@@ -0,0 +1,248 @@
+package schema
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates the files in dir, keyed by their slash-separated path
+// relative to dir. A key ending with a slash creates an empty directory.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if strings.HasSuffix(name, "/") {
+			if err := os.MkdirAll(path, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+const (
+	datasourceFile = "datasource db {\n  provider = \"postgresql\"\n  url = env(\"DATABASE_URL\")\n}\n"
+	userFile       = "model User {\n  id Int @id\n  role Role\n}\n"
+	roleFile       = "enum Role {\n  USER\n}\n"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name   string
+		files  map[string]string
+		path   string
+		models []string
+		enums  []string
+		nFiles int
+	}{
+		{
+			name:   "single file",
+			files:  map[string]string{"schema.prisma": datasourceFile + userFile + roleFile},
+			path:   "schema.prisma",
+			models: []string{"User"},
+			enums:  []string{"Role"},
+			nFiles: 1,
+		},
+		{
+			name: "folder",
+			files: map[string]string{
+				"schema/schema.prisma":      datasourceFile,
+				"schema/models/user.prisma": userFile,
+				"schema/enums.prisma":       roleFile,
+				"schema/README.md":          "not a schema",
+			},
+			path:   "schema",
+			models: []string{"User"},
+			enums:  []string{"Role"},
+			nFiles: 3,
+		},
+		{
+			name: "glob pattern",
+			files: map[string]string{
+				"schema/schema.prisma": datasourceFile,
+				"schema/user.prisma":   userFile,
+				"schema/role.prisma":   roleFile,
+				"other/post.prisma":    "model Post {\n  id Int @id\n}\n",
+			},
+			path:   "schema/*.prisma",
+			models: []string{"User"},
+			enums:  []string{"Role"},
+			nFiles: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			s, err := Load(filepath.Join(dir, tt.path))
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if len(s.Files) != tt.nFiles {
+				t.Errorf("Files = %v, want %d files", s.Files, tt.nFiles)
+			}
+			if len(s.Datasources) != 1 {
+				t.Errorf("len(Datasources) = %d, want 1", len(s.Datasources))
+			}
+			for _, name := range tt.models {
+				if s.Model(name) == nil {
+					t.Errorf("model %s not loaded", name)
+				}
+			}
+			for _, name := range tt.enums {
+				if s.Enum(name) == nil {
+					t.Errorf("enum %s not loaded", name)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadPositions(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"schema/schema.prisma": datasourceFile,
+		"schema/user.prisma":   userFile,
+	})
+
+	s, err := Load(filepath.Join(dir, "schema"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := filepath.Join(dir, "schema", "user.prisma") + ":1:1"
+	if got := s.Model("User").Pos.String(); got != want {
+		t.Errorf("User position = %s, want %s", got, want)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		path  string
+		want  string
+	}{
+		{
+			name: "missing file",
+			path: "schema.prisma",
+			want: "error reading schema file",
+		},
+		{
+			name:  "folder without schema files",
+			files: map[string]string{"schema/README.md": ""},
+			path:  "schema",
+			want:  "no .prisma files found in",
+		},
+		{
+			name:  "pattern without matches",
+			files: map[string]string{"schema/": ""},
+			path:  "schema/*.prisma",
+			want:  "no schema files match",
+		},
+		{
+			name:  "invalid file in a folder",
+			files: map[string]string{"schema/a.prisma": userFile, "schema/b.prisma": "model {"},
+			path:  "schema",
+			want:  "b.prisma:1:7: error: unexpected '{'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			_, err := Load(filepath.Join(dir, tt.path))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load() error = %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrationsDir(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		path  string
+		want  string
+	}{
+		{
+			name:  "next to the schema file",
+			files: map[string]string{"prisma/schema.prisma": datasourceFile, "prisma/migrations/": ""},
+			path:  "prisma/schema.prisma",
+			want:  "prisma/migrations",
+		},
+		{
+			name:  "default next to the schema file",
+			files: map[string]string{"prisma/schema.prisma": datasourceFile},
+			path:  "prisma/schema.prisma",
+			want:  "prisma/migrations",
+		},
+		{
+			name: "next to the datasource file of a folder",
+			files: map[string]string{
+				"prisma/schema/config/db.prisma":   datasourceFile,
+				"prisma/schema/user.prisma":        userFile,
+				"prisma/schema/config/migrations/": "",
+				"prisma/schema/migrations/":        "",
+			},
+			path: "prisma/schema",
+			want: "prisma/schema/config/migrations",
+		},
+		{
+			name: "in the schema folder",
+			files: map[string]string{
+				"prisma/schema/config/db.prisma": datasourceFile,
+				"prisma/schema/migrations/":      "",
+				"prisma/migrations/":             "",
+			},
+			path: "prisma/schema",
+			want: "prisma/schema/migrations",
+		},
+		{
+			name: "next to the schema folder",
+			files: map[string]string{
+				"prisma/schema/db.prisma": "model User {\n  id Int @id\n}\n",
+				"prisma/migrations/":      "",
+			},
+			path: "prisma/schema",
+			want: "prisma/migrations",
+		},
+		{
+			name: "next to the folder of a glob pattern",
+			files: map[string]string{
+				"prisma/schema/user.prisma": userFile,
+				"prisma/migrations/":        "",
+			},
+			path: "prisma/schema/*.prisma",
+			want: "prisma/migrations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+
+			s, err := Load(filepath.Join(dir, tt.path))
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			want := filepath.Join(dir, filepath.FromSlash(tt.want))
+			if got := s.MigrationsDir(); got != want {
+				t.Errorf("MigrationsDir() = %s, want %s", got, want)
+			}
+		})
+	}
+}
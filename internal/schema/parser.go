@@ -0,0 +1,539 @@
+package schema
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ParseFile reads and parses the schema file at path.
+func ParseFile(path string) (*Schema, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema file: %w", err)
+	}
+	s, err := Parse(path, src)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Path = path
+	s.Files = []string{path}
+	return s, nil
+}
+
+// Parse parses the source of a single schema file. Filename is only used to
+// build the positions of the resulting AST nodes and errors.
+func Parse(filename string, src []byte) (*Schema, error) {
+	tokens, err := lex(filename, src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	return p.parseSchema()
+}
+
+// parser is a recursive descent parser over the token stream. Newlines
+// separate fields and properties, except inside parentheses and brackets
+// where depth is positive and they are skipped, so attributes can span
+// multiple lines.
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+	doc    []string
+}
+
+func (p *parser) peek() token {
+	for p.depth > 0 {
+		kind := p.tokens[p.pos].kind
+		if kind != tokenNewline && kind != tokenDocComment {
+			break
+		}
+		p.pos++
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.peek()
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind, context string) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, unexpected(tok, kind.String(), context)
+	}
+	return tok, nil
+}
+
+func unexpected(tok token, expected, context string) error {
+	msg := fmt.Sprintf("unexpected %s, expected %s", tok, expected)
+	if context != "" {
+		msg += " " + context
+	}
+	return errorf(tok.pos, "%s", msg)
+}
+
+// takeDoc returns the pending doc comment lines and resets them.
+func (p *parser) takeDoc() string {
+	doc := strings.Join(p.doc, "\n")
+	p.doc = nil
+	return doc
+}
+
+// endLine consumes the end of a field, property or block line. A trailing
+// doc comment on the same line is returned so it can be attached to the
+// element it follows.
+func (p *parser) endLine(context string) (string, error) {
+	var trailing string
+	if tok := p.peek(); tok.kind == tokenDocComment {
+		p.next()
+		trailing = tok.text
+	}
+
+	switch tok := p.peek(); tok.kind {
+	case tokenNewline:
+		p.next()
+		return trailing, nil
+	case tokenRBrace, tokenEOF:
+		return trailing, nil
+	default:
+		return "", unexpected(tok, "a new line", context)
+	}
+}
+
+func joinDoc(doc, trailing string) string {
+	if trailing == "" {
+		return doc
+	}
+	if doc == "" {
+		return trailing
+	}
+	return doc + "\n" + trailing
+}
+
+func (p *parser) parseSchema() (*Schema, error) {
+	s := &Schema{}
+
+	for {
+		tok := p.next()
+		switch tok.kind {
+		case tokenEOF:
+			return s, nil
+		case tokenNewline:
+			continue
+		case tokenDocComment:
+			p.doc = append(p.doc, tok.text)
+			continue
+		case tokenIdent:
+		default:
+			return nil, unexpected(tok, "a block declaration", "")
+		}
+
+		doc := p.takeDoc()
+
+		switch tok.text {
+		case "model", "view", "type":
+			model, err := p.parseModel(BlockKind(tok.text), tok.pos, doc)
+			if err != nil {
+				return nil, err
+			}
+			switch model.Kind {
+			case ModelBlock:
+				s.Models = append(s.Models, model)
+			case ViewBlock:
+				s.Views = append(s.Views, model)
+			case TypeBlock:
+				s.Types = append(s.Types, model)
+			}
+
+		case "enum":
+			enum, err := p.parseEnum(tok.pos, doc)
+			if err != nil {
+				return nil, err
+			}
+			s.Enums = append(s.Enums, enum)
+
+		case "datasource", "generator":
+			block, err := p.parseConfigBlock(tok.text, tok.pos, doc)
+			if err != nil {
+				return nil, err
+			}
+			if block.Kind == "datasource" {
+				s.Datasources = append(s.Datasources, block)
+			} else {
+				s.Generators = append(s.Generators, block)
+			}
+
+		default:
+			return nil, errorf(
+				tok.pos,
+				"unknown block type %q, expected one of model, view, type, enum, datasource or generator",
+				tok.text,
+			)
+		}
+	}
+}
+
+func (p *parser) parseModel(
+	kind BlockKind,
+	pos Position,
+	doc string,
+) (*Model, error) {
+	name, err := p.expect(tokenIdent, fmt.Sprintf("after %q", kind))
+	if err != nil {
+		return nil, err
+	}
+
+	model := &Model{Kind: kind, Name: name.text, Doc: doc, Pos: pos}
+	context := fmt.Sprintf("in %s %s", kind, model.Name)
+
+	if _, err := p.expect(tokenLBrace, context); err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.next()
+		switch tok.kind {
+		case tokenNewline:
+		case tokenDocComment:
+			p.doc = append(p.doc, tok.text)
+		case tokenRBrace:
+			p.doc = nil
+			if _, err := p.endLine(context); err != nil {
+				return nil, err
+			}
+			return model, nil
+		case tokenAtAt:
+			p.doc = nil
+			attr, err := p.parseAttribute(tok.pos, context)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.endLine(context); err != nil {
+				return nil, err
+			}
+			model.Attributes = append(model.Attributes, attr)
+		case tokenIdent:
+			field, err := p.parseField(tok, context)
+			if err != nil {
+				return nil, err
+			}
+			model.Fields = append(model.Fields, field)
+		default:
+			return nil, unexpected(tok, "a field, block attribute or '}'", context)
+		}
+	}
+}
+
+func (p *parser) parseField(name token, context string) (*Field, error) {
+	field := &Field{Name: name.text, Doc: p.takeDoc(), Pos: name.pos}
+	context = fmt.Sprintf("for field %s %s", field.Name, context)
+
+	typ, err := p.expect(tokenIdent, context)
+	if err != nil {
+		return nil, err
+	}
+	field.Type = FieldType{Name: typ.text, Pos: typ.pos}
+
+	if typ.text == "Unsupported" && p.peek().kind == tokenLParen {
+		p.next()
+		p.depth++
+		arg, err := p.expect(tokenString, context)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen, context); err != nil {
+			return nil, err
+		}
+		p.depth--
+		field.Type.Unsupported = arg.text
+	}
+
+	if p.peek().kind == tokenLBracket {
+		p.next()
+		if _, err := p.expect(tokenRBracket, context); err != nil {
+			return nil, err
+		}
+		field.Type.List = true
+	}
+
+	if p.peek().kind == tokenQuestion {
+		p.next()
+		field.Type.Optional = true
+	}
+
+	for p.peek().kind == tokenAt {
+		at := p.next()
+		attr, err := p.parseAttribute(at.pos, context)
+		if err != nil {
+			return nil, err
+		}
+		field.Attributes = append(field.Attributes, attr)
+	}
+
+	trailing, err := p.endLine(context)
+	if err != nil {
+		return nil, err
+	}
+	field.Doc = joinDoc(field.Doc, trailing)
+
+	return field, nil
+}
+
+func (p *parser) parseEnum(pos Position, doc string) (*Enum, error) {
+	name, err := p.expect(tokenIdent, `after "enum"`)
+	if err != nil {
+		return nil, err
+	}
+
+	enum := &Enum{Name: name.text, Doc: doc, Pos: pos}
+	context := fmt.Sprintf("in enum %s", enum.Name)
+
+	if _, err := p.expect(tokenLBrace, context); err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.next()
+		switch tok.kind {
+		case tokenNewline:
+		case tokenDocComment:
+			p.doc = append(p.doc, tok.text)
+		case tokenRBrace:
+			p.doc = nil
+			if _, err := p.endLine(context); err != nil {
+				return nil, err
+			}
+			return enum, nil
+		case tokenAtAt:
+			p.doc = nil
+			attr, err := p.parseAttribute(tok.pos, context)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.endLine(context); err != nil {
+				return nil, err
+			}
+			enum.Attributes = append(enum.Attributes, attr)
+		case tokenIdent:
+			value := &EnumValue{Name: tok.text, Doc: p.takeDoc(), Pos: tok.pos}
+			for p.peek().kind == tokenAt {
+				at := p.next()
+				attr, err := p.parseAttribute(at.pos, context)
+				if err != nil {
+					return nil, err
+				}
+				value.Attributes = append(value.Attributes, attr)
+			}
+			trailing, err := p.endLine(context)
+			if err != nil {
+				return nil, err
+			}
+			value.Doc = joinDoc(value.Doc, trailing)
+			enum.Values = append(enum.Values, value)
+		default:
+			return nil, unexpected(tok, "an enum value, block attribute or '}'", context)
+		}
+	}
+}
+
+func (p *parser) parseConfigBlock(
+	kind string,
+	pos Position,
+	doc string,
+) (*ConfigBlock, error) {
+	name, err := p.expect(tokenIdent, fmt.Sprintf("after %q", kind))
+	if err != nil {
+		return nil, err
+	}
+
+	block := &ConfigBlock{Kind: kind, Name: name.text, Doc: doc, Pos: pos}
+	context := fmt.Sprintf("in %s %s", kind, block.Name)
+
+	if _, err := p.expect(tokenLBrace, context); err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.next()
+		switch tok.kind {
+		case tokenNewline, tokenDocComment:
+		case tokenRBrace:
+			if _, err := p.endLine(context); err != nil {
+				return nil, err
+			}
+			return block, nil
+		case tokenIdent:
+			if _, err := p.expect(tokenEqual, context); err != nil {
+				return nil, err
+			}
+			value, err := p.parseExpr(context)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.endLine(context); err != nil {
+				return nil, err
+			}
+			block.Properties = append(block.Properties, &Property{
+				Name:  tok.text,
+				Value: value,
+				Pos:   tok.pos,
+			})
+		default:
+			return nil, unexpected(tok, "a property or '}'", context)
+		}
+	}
+}
+
+// parseAttribute parses an attribute after its leading `@` or `@@`.
+func (p *parser) parseAttribute(pos Position, context string) (*Attribute, error) {
+	name, err := p.parseDottedName(context)
+	if err != nil {
+		return nil, err
+	}
+
+	attr := &Attribute{Name: name, Pos: pos}
+	if p.peek().kind == tokenLParen {
+		attr.Args, err = p.parseArguments(context)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return attr, nil
+}
+
+func (p *parser) parseDottedName(context string) (string, error) {
+	tok, err := p.expect(tokenIdent, context)
+	if err != nil {
+		return "", err
+	}
+
+	name := tok.text
+	for p.peek().kind == tokenDot {
+		p.next()
+		part, err := p.expect(tokenIdent, context)
+		if err != nil {
+			return "", err
+		}
+		name += "." + part.text
+	}
+
+	return name, nil
+}
+
+// parseArguments parses a parenthesized argument list.
+func (p *parser) parseArguments(context string) ([]*Argument, error) {
+	if _, err := p.expect(tokenLParen, context); err != nil {
+		return nil, err
+	}
+	p.depth++
+
+	var args []*Argument
+	for {
+		if p.peek().kind == tokenRParen {
+			p.next()
+			p.depth--
+			return args, nil
+		}
+
+		arg, err := p.parseArgument(context)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+
+		tok := p.next()
+		switch tok.kind {
+		case tokenComma:
+		case tokenRParen:
+			p.depth--
+			return args, nil
+		default:
+			return nil, unexpected(tok, "',' or ')'", context)
+		}
+	}
+}
+
+func (p *parser) parseArgument(context string) (*Argument, error) {
+	start := p.pos
+	if tok := p.next(); tok.kind == tokenIdent && p.peek().kind == tokenColon {
+		p.next()
+		value, err := p.parseExpr(context)
+		if err != nil {
+			return nil, err
+		}
+		return &Argument{Name: tok.text, Value: value, Pos: tok.pos}, nil
+	}
+	p.pos = start
+
+	value, err := p.parseExpr(context)
+	if err != nil {
+		return nil, err
+	}
+	return &Argument{Value: value, Pos: value.Position()}, nil
+}
+
+func (p *parser) parseExpr(context string) (Expr, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenString:
+		p.next()
+		return &StringLit{Value: tok.text, Pos: tok.pos}, nil
+
+	case tokenNumber:
+		p.next()
+		return &NumberLit{Value: tok.text, Pos: tok.pos}, nil
+
+	case tokenLBracket:
+		p.next()
+		p.depth++
+		array := &ArrayLit{Pos: tok.pos}
+		for {
+			if p.peek().kind == tokenRBracket {
+				p.next()
+				p.depth--
+				return array, nil
+			}
+
+			elem, err := p.parseExpr(context)
+			if err != nil {
+				return nil, err
+			}
+			array.Elems = append(array.Elems, elem)
+
+			next := p.next()
+			switch next.kind {
+			case tokenComma:
+			case tokenRBracket:
+				p.depth--
+				return array, nil
+			default:
+				return nil, unexpected(next, "',' or ']'", context)
+			}
+		}
+
+	case tokenIdent:
+		name, err := p.parseDottedName(context)
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != tokenLParen {
+			return &Ident{Name: name, Pos: tok.pos}, nil
+		}
+		args, err := p.parseArguments(context)
+		if err != nil {
+			return nil, err
+		}
+		return &FuncCall{Name: name, Args: args, Pos: tok.pos}, nil
+	}
+
+	return nil, unexpected(tok, "a value", context)
+}
@@ -0,0 +1,422 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func mustParse(t *testing.T, src string) *Schema {
+	t.Helper()
+	s, err := Parse("schema.prisma", []byte(src))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return s
+}
+
+func TestParseBlocks(t *testing.T) {
+	s := mustParse(t, `
+datasource db {
+  provider = "postgresql"
+  url      = env("DATABASE_URL")
+  schemas  = ["public", "billing"]
+}
+
+generator client {
+  provider = "prisma-client-js"
+}
+
+model User {
+  id Int @id
+}
+
+view UserInfo {
+  id Int @unique
+}
+
+type Address {
+  street String
+}
+
+enum Role {
+  USER
+  ADMIN @map("admin")
+}
+`)
+
+	if got := len(s.Datasources); got != 1 {
+		t.Fatalf("len(Datasources) = %d, want 1", got)
+	}
+	properties := []struct {
+		name string
+		want string
+	}{
+		{"provider", `"postgresql"`},
+		{"url", `env("DATABASE_URL")`},
+		{"schemas", `["public", "billing"]`},
+	}
+	for _, tt := range properties {
+		if got := s.Datasources[0].Property(tt.name).Value.String(); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+	if len(s.Generators) != 1 || s.Generators[0].Name != "client" {
+		t.Errorf("Generators = %v, want the client generator", s.Generators)
+	}
+
+	blocks := []struct {
+		blocks []*Model
+		kind   BlockKind
+		name   string
+	}{
+		{s.Models, ModelBlock, "User"},
+		{s.Views, ViewBlock, "UserInfo"},
+		{s.Types, TypeBlock, "Address"},
+	}
+	for _, tt := range blocks {
+		if len(tt.blocks) != 1 || tt.blocks[0].Name != tt.name {
+			t.Errorf("%s blocks = %v, want %s", tt.kind, tt.blocks, tt.name)
+			continue
+		}
+		if tt.blocks[0].Kind != tt.kind {
+			t.Errorf("%s.Kind = %q, want %q", tt.name, tt.blocks[0].Kind, tt.kind)
+		}
+	}
+
+	role := s.Enum("Role")
+	if role == nil {
+		t.Fatal("enum Role not parsed")
+	}
+	var values []string
+	for _, value := range role.Values {
+		values = append(values, value.DBName())
+	}
+	if got := strings.Join(values, ","); got != "USER,admin" {
+		t.Errorf("Role values = %q, want %q", got, "USER,admin")
+	}
+}
+
+func TestParseDocComments(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		modelDoc  string
+		fieldDocs map[string]string
+	}{
+		{
+			name: "regular comments are dropped",
+			src: `// model comment
+model User {
+  // field comment
+  id Int @id // trailing comment
+}`,
+			fieldDocs: map[string]string{"id": ""},
+		},
+		{
+			name: "doc comments are attached to the next element",
+			src: `/// A user.
+/// Second line.
+model User {
+  /// The id.
+  id Int @id
+}`,
+			modelDoc:  "A user.\nSecond line.",
+			fieldDocs: map[string]string{"id": "The id."},
+		},
+		{
+			name: "trailing doc comments are attached to the field",
+			src: `model User {
+  /// The id.
+  id    Int    @id /// Auto generated.
+  email String /// Unique.
+}`,
+			fieldDocs: map[string]string{
+				"id":    "The id.\nAuto generated.",
+				"email": "Unique.",
+			},
+		},
+		{
+			name: "doc comments before block attributes are dropped",
+			src: `model User {
+  id Int
+  /// Not a field doc.
+  @@id([id])
+}`,
+			fieldDocs: map[string]string{"id": ""},
+		},
+		{
+			name: "comment markers inside strings are kept",
+			src: `model User {
+  url String @default("http://example.com") /// Homepage.
+}`,
+			fieldDocs: map[string]string{"url": "Homepage."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := mustParse(t, tt.src).Model("User")
+			if model.Doc != tt.modelDoc {
+				t.Errorf("model Doc = %q, want %q", model.Doc, tt.modelDoc)
+			}
+			for name, want := range tt.fieldDocs {
+				field := model.Field(name)
+				if field == nil {
+					t.Fatalf("field %s not parsed", name)
+				}
+				if field.Doc != want {
+					t.Errorf("field %s Doc = %q, want %q", name, field.Doc, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseFieldTypes(t *testing.T) {
+	tests := []struct {
+		field string
+		want  FieldType
+	}{
+		{`a String`, FieldType{Name: "String"}},
+		{`a String?`, FieldType{Name: "String", Optional: true}},
+		{`a String[]`, FieldType{Name: "String", List: true}},
+		{`a Post[]`, FieldType{Name: "Post", List: true}},
+		{`a Unsupported("circle")`, FieldType{Name: "Unsupported", Unsupported: "circle"}},
+		{`a Unsupported("polygon")?`, FieldType{Name: "Unsupported", Unsupported: "polygon", Optional: true}},
+		{`a Unsupported("int4range")[]`, FieldType{Name: "Unsupported", Unsupported: "int4range", List: true}},
+		{`a Unsupported("geometry(Point, 4326)")`, FieldType{Name: "Unsupported", Unsupported: "geometry(Point, 4326)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			field := mustParse(t, "model M {\n  "+tt.field+"\n}").Model("M").Field("a")
+			got := field.Type
+			got.Pos = Position{}
+			if got != tt.want {
+				t.Errorf("Type = %+v, want %+v", got, tt.want)
+			}
+			if got.String() != strings.TrimPrefix(tt.field, "a ") {
+				t.Errorf("Type.String() = %q, want %q", got.String(), strings.TrimPrefix(tt.field, "a "))
+			}
+		})
+	}
+}
+
+func TestParseAttributes(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		// want lists the attributes of the field, or of the model for block
+		// attributes, as rendered by Attribute.String.
+		want []string
+	}{
+		{
+			name: "attributes without arguments",
+			line: `a Int @id @unique`,
+			want: []string{"id", "unique"},
+		},
+		{
+			name: "function call arguments",
+			line: `a String @default(uuid(7))`,
+			want: []string{"default(uuid(7))"},
+		},
+		{
+			name: "nested function calls",
+			line: `a String @default(dbgenerated("gen_random_uuid()"))`,
+			want: []string{`default(dbgenerated("gen_random_uuid()"))`},
+		},
+		{
+			name: "native types",
+			line: `a String @db.VarChar(255)`,
+			want: []string{"db.VarChar(255)"},
+		},
+		{
+			name: "negative and decimal numbers",
+			line: `a Float @default(-1.5)`,
+			want: []string{"default(-1.5)"},
+		},
+		{
+			name: "named arguments and arrays",
+			line: `a User @relation("Author", fields: [authorId], references: [id], onDelete: Cascade)`,
+			want: []string{`relation("Author", fields: [authorId], references: [id], onDelete: Cascade)`},
+		},
+		{
+			name: "block attributes with nested arguments",
+			line: `@@index([title(ops: raw("gin_trgm_ops")), createdAt(sort: Desc)], type: Gin)`,
+			want: []string{`index([title(ops: raw("gin_trgm_ops")), createdAt(sort: Desc)], type: Gin)`},
+		},
+		{
+			name: "arguments spanning several lines",
+			line: "@@unique(\n    [a,\n     b],\n    name: \"a_b\"\n  )",
+			want: []string{`unique([a, b], name: "a_b")`},
+		},
+		{
+			name: "empty argument lists",
+			line: `a String @default([])`,
+			want: []string{"default([])"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := mustParse(t, "model M {\n  "+tt.line+"\n}").Model("M")
+			attrs := model.Attributes
+			if len(model.Fields) > 0 {
+				attrs = model.Fields[0].Attributes
+			}
+			var got []string
+			for _, attr := range attrs {
+				got = append(got, attr.String())
+			}
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("attributes = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttributeArg(t *testing.T) {
+	field := mustParse(t, `model M {
+  a User @relation("Author", fields: [authorId], references: [id])
+}`).Model("M").Field("a")
+	attr := field.Attribute("relation")
+
+	tests := []struct {
+		index int
+		name  string
+		want  string
+	}{
+		{0, "name", `"Author"`},
+		{-1, "fields", "[authorId]"},
+		{-1, "references", "[id]"},
+		{1, "", "<nil>"},
+		{-1, "onDelete", "<nil>"},
+	}
+	for _, tt := range tests {
+		got := "<nil>"
+		if arg := attr.Arg(tt.index, tt.name); arg != nil {
+			got = arg.Value.String()
+		}
+		if got != tt.want {
+			t.Errorf("Arg(%d, %q) = %s, want %s", tt.index, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseStringEscapes(t *testing.T) {
+	tests := []struct {
+		literal string
+		want    string
+	}{
+		{`"plain"`, "plain"},
+		{`""`, ""},
+		{`"say \"hi\""`, `say "hi"`},
+		{`"back\\slash"`, `back\slash`},
+		{`"tab\there"`, "tab\there"},
+		{`"new\nline"`, "new\nline"},
+		{`"café"`, "café"},
+		{`"a } b @map(\"x\") // not a comment"`, `a } b @map("x") // not a comment`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.literal, func(t *testing.T) {
+			field := mustParse(t, "model M {\n  a String @default("+tt.literal+")\n}").Model("M").Field("a")
+			got, ok := StringValue(field.Attribute("default").Arg(0, "value").Value)
+			if !ok {
+				t.Fatalf("default value is not a string literal")
+			}
+			if got != tt.want {
+				t.Errorf("value = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePositions(t *testing.T) {
+	s := mustParse(t, "model User {\n  id    Int @id\n  posts Post[] @relation(\"A\")\n}\n")
+	model := s.Model("User")
+	posts := model.Field("posts")
+
+	tests := []struct {
+		name string
+		pos  Position
+		want string
+	}{
+		{"model", model.Pos, "schema.prisma:1:1"},
+		{"field", model.Field("id").Pos, "schema.prisma:2:3"},
+		{"field type", posts.Type.Pos, "schema.prisma:3:9"},
+		{"attribute", posts.Attribute("relation").Pos, "schema.prisma:3:16"},
+		{"argument", posts.Attribute("relation").Args[0].Pos, "schema.prisma:3:26"},
+	}
+	for _, tt := range tests {
+		if got := tt.pos.String(); got != tt.want {
+			t.Errorf("%s position = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "unterminated string",
+			src:  "model M {\n  a String @default(\"abc)\n}",
+			want: `schema.prisma:2:21: error: unterminated string literal`,
+		},
+		{
+			name: "unexpected character",
+			src:  "model M {\n  a String $\n}",
+			want: `schema.prisma:2:12: error: unexpected character '$'`,
+		},
+		{
+			name: "unknown block type",
+			src:  "\ntable M {\n}",
+			want: `schema.prisma:2:1: error: unknown block type "table", expected one of model, view, type, enum, datasource or generator`,
+		},
+		{
+			name: "missing field type",
+			src:  "model M {\n  a\n}",
+			want: `schema.prisma:2:4: error: unexpected newline, expected identifier for field a in model M`,
+		},
+		{
+			name: "missing block name",
+			src:  "model {\n}",
+			want: `schema.prisma:1:7: error: unexpected '{', expected identifier after "model"`,
+		},
+		{
+			name: "unclosed argument list",
+			src:  "model M {\n  a String @default(\"x\" \"y\")\n}",
+			want: `schema.prisma:2:25: error: unexpected string "y", expected ',' or ')' for field a in model M`,
+		},
+		{
+			name: "two fields on a line",
+			src:  "model M {\n  a String b Int\n}",
+			want: `schema.prisma:2:12: error: unexpected identifier "b", expected a new line for field a in model M`,
+		},
+		{
+			name: "unterminated block",
+			src:  "enum Role {\n  USER\n",
+			want: `schema.prisma:3:1: error: unexpected end of file, expected an enum value, block attribute or '}' in enum Role`,
+		},
+		{
+			name: "property without value",
+			src:  "datasource db {\n  provider =\n}",
+			want: `schema.prisma:2:13: error: unexpected newline, expected a value in datasource db`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("schema.prisma", []byte(tt.src))
+			if err == nil {
+				t.Fatal("Parse() error = nil, want an error")
+			}
+			if err.Error() != tt.want {
+				t.Errorf("Parse() error = %q, want %q", err, tt.want)
+			}
+		})
+	}
+}
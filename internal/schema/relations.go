@@ -0,0 +1,49 @@
+package schema
+
+// Relation is the @relation attribute of a relation field.
+type Relation struct {
+	// Name pairs both sides of a relation when two models have more than one
+	// relation between them.
+	Name string
+	// Fields are the scalar fields of the model holding the foreign key,
+	// empty on the other side of the relation.
+	Fields []string
+	// References are the fields of the related model the foreign key
+	// references, in the order of Fields.
+	References []string
+	// OnDelete and OnUpdate are the referential actions set on the side
+	// holding the foreign key, e.g. Cascade, empty when not set.
+	OnDelete string
+	OnUpdate string
+	Pos      Position
+}
+
+// Relation returns the @relation attribute of the field, or nil when it has
+// none.
+func (f *Field) Relation() *Relation {
+	attr := f.Attribute("relation")
+	if attr == nil {
+		return nil
+	}
+	fields, _ := relationFields(f, "fields")
+	references, _ := relationFields(f, "references")
+	return &Relation{
+		Name:       relationName(f),
+		Fields:     fields,
+		References: references,
+		OnDelete:   referentialAction(attr, "onDelete"),
+		OnUpdate:   referentialAction(attr, "onUpdate"),
+		Pos:        attr.Pos,
+	}
+}
+
+func referentialAction(attr *Attribute, name string) string {
+	arg := attr.Arg(-1, name)
+	if arg == nil {
+		return ""
+	}
+	if ident, ok := arg.Value.(*Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldRelation(t *testing.T) {
+	s := mustParse(t, `model User {
+  id       Int    @id
+  posts    Post[] @relation("Author")
+  reviews  Post[] @relation("Reviewer")
+}
+
+model Post {
+  id         Int   @id
+  authorId   Int
+  author     User  @relation("Author", fields: [authorId], references: [id], onDelete: Cascade, onUpdate: Restrict)
+  reviewerId Int?
+  reviewer   User? @relation(name: "Reviewer", fields: [reviewerId], references: [id])
+}`)
+	user, post := s.Model("User"), s.Model("Post")
+
+	tests := []struct {
+		field *Field
+		want  *Relation
+	}{
+		{user.Field("id"), nil},
+		{user.Field("posts"), &Relation{Name: "Author"}},
+		{
+			post.Field("author"),
+			&Relation{
+				Name:       "Author",
+				Fields:     []string{"authorId"},
+				References: []string{"id"},
+				OnDelete:   "Cascade",
+				OnUpdate:   "Restrict",
+			},
+		},
+		{
+			post.Field("reviewer"),
+			&Relation{
+				Name:       "Reviewer",
+				Fields:     []string{"reviewerId"},
+				References: []string{"id"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field.Name, func(t *testing.T) {
+			got := tt.field.Relation()
+			if got != nil {
+				got.Pos = Position{}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Relation() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package schema
+
+import "fmt"
+
+// Position describes a location in a Prisma schema file.
+type Position struct {
+	Filename string
+	Offset   int
+	Line     int
+	Column   int
+}
+
+// IsValid reports whether the position points to an actual location.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+func (p Position) String() string {
+	filename := p.Filename
+	if filename == "" {
+		filename = "<input>"
+	}
+	if !p.IsValid() {
+		return filename
+	}
+	return fmt.Sprintf("%s:%d:%d", filename, p.Line, p.Column)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNewline
+	tokenDocComment
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenLBrace
+	tokenRBrace
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+	tokenColon
+	tokenEqual
+	tokenQuestion
+	tokenDot
+	tokenAt
+	tokenAtAt
+)
+
+var tokenNames = map[tokenKind]string{
+	tokenEOF:        "end of file",
+	tokenNewline:    "newline",
+	tokenDocComment: "doc comment",
+	tokenIdent:      "identifier",
+	tokenString:     "string",
+	tokenNumber:     "number",
+	tokenLBrace:     "'{'",
+	tokenRBrace:     "'}'",
+	tokenLParen:     "'('",
+	tokenRParen:     "')'",
+	tokenLBracket:   "'['",
+	tokenRBracket:   "']'",
+	tokenComma:      "','",
+	tokenColon:      "':'",
+	tokenEqual:      "'='",
+	tokenQuestion:   "'?'",
+	tokenDot:        "'.'",
+	tokenAt:         "'@'",
+	tokenAtAt:       "'@@'",
+}
+
+func (k tokenKind) String() string {
+	return tokenNames[k]
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  Position
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenIdent, tokenNumber:
+		return fmt.Sprintf("%s %q", t.kind, t.text)
+	case tokenString:
+		return fmt.Sprintf("string %q", t.text)
+	}
+	return t.kind.String()
+}
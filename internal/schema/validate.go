@@ -0,0 +1,520 @@
+package schema
+
+import (
+	"fmt"
+	"slices"
+)
+
+// scalarTypes lists the built-in Prisma scalar types.
+var scalarTypes = []string{
+	"String",
+	"Boolean",
+	"Int",
+	"BigInt",
+	"Float",
+	"Decimal",
+	"DateTime",
+	"Json",
+	"Bytes",
+	"Unsupported",
+}
+
+// IsScalar reports whether name is a built-in Prisma scalar type.
+func IsScalar(name string) bool {
+	return slices.Contains(scalarTypes, name)
+}
+
+// Validate checks the schema for the problems that would otherwise make the
+// generators emit incomplete or wrong code: duplicate declarations, unknown
+// types, unresolved relations and invalid @map/@@map arguments.
+func Validate(s *Schema) Diagnostics {
+	v := &validator{schema: s}
+
+	v.checkDatasources()
+	v.checkDuplicateBlocks()
+	v.checkSchemas()
+
+	for _, model := range v.blocks() {
+		v.checkMap(model.Attributes, "@@map")
+		v.checkModelFields(model)
+
+		element := fmt.Sprintf("%s %s", model.Kind, model.Name)
+		v.checkAnnotations(model.Doc, element, model.Pos, annotateModel)
+		for _, field := range model.Fields {
+			element := fmt.Sprintf("field %s.%s", model.Name, field.Name)
+			v.checkAnnotations(field.Doc, element, field.Pos, annotateField)
+		}
+	}
+
+	for _, model := range slices.Concat(s.Models, s.Views) {
+		v.checkKeys(model)
+	}
+	for _, model := range s.Models {
+		v.checkUniqueCriteria(model)
+	}
+
+	for _, enum := range s.Enums {
+		v.checkMap(enum.Attributes, "@@map")
+		v.checkEnumValues(enum)
+
+		v.checkAnnotations(enum.Doc, "enum "+enum.Name, enum.Pos, annotateEnum)
+		for _, value := range enum.Values {
+			element := fmt.Sprintf("enum value %s.%s", enum.Name, value.Name)
+			v.checkAnnotations(value.Doc, element, value.Pos, annotateEnum)
+		}
+	}
+
+	return v.diags
+}
+
+type validator struct {
+	schema *Schema
+	diags  Diagnostics
+}
+
+func (v *validator) blocks() []*Model {
+	blocks := make([]*Model, 0, len(v.schema.Models)+len(v.schema.Views)+len(v.schema.Types))
+	blocks = append(blocks, v.schema.Models...)
+	blocks = append(blocks, v.schema.Views...)
+	blocks = append(blocks, v.schema.Types...)
+	return blocks
+}
+
+func (v *validator) checkDatasources() {
+	for _, ds := range v.schema.Datasources[min(1, len(v.schema.Datasources)):] {
+		v.diags.Errorf(
+			ds.Pos,
+			"duplicate datasource %s, a schema can only have one datasource (first declared at %s)",
+			ds.Name,
+			v.schema.Datasources[0].Pos,
+		)
+	}
+}
+
+// checkSchemas validates the @@schema attributes of the multiSchema
+// feature: every model, view and enum must name one of the schemas listed
+// by the datasource, and none may when the datasource lists no schemas.
+func (v *validator) checkSchemas() {
+	schemas := v.schema.DatabaseSchemas()
+
+	check := func(kind, name string, attrs []*Attribute, pos Position) {
+		attr := findAttribute(attrs, "schema")
+		if attr == nil {
+			if len(schemas) > 0 {
+				v.diags.Errorf(
+					pos,
+					"%s %s must define its database schema with @@schema, the datasource lists schemas",
+					kind,
+					name,
+				)
+			}
+			return
+		}
+
+		arg := attr.Arg(0, "name")
+		if len(attr.Args) != 1 || arg == nil {
+			v.diags.Errorf(attr.Pos, "@@schema expects a single string argument")
+			return
+		}
+		value, ok := StringValue(arg.Value)
+		if !ok || value == "" {
+			v.diags.Errorf(arg.Pos, "@@schema expects a non-empty string argument, got %s", arg.Value)
+			return
+		}
+		if !slices.Contains(schemas, value) {
+			v.diags.Errorf(
+				arg.Pos,
+				"schema %q of %s %s is not listed in the schemas of the datasource",
+				value,
+				kind,
+				name,
+			)
+		}
+	}
+
+	for _, model := range slices.Concat(v.schema.Models, v.schema.Views) {
+		check(string(model.Kind), model.Name, model.Attributes, model.Pos)
+	}
+	for _, enum := range v.schema.Enums {
+		check("enum", enum.Name, enum.Attributes, enum.Pos)
+	}
+}
+
+func (v *validator) checkDuplicateBlocks() {
+	declared := map[string]Position{}
+	declare := func(kind, name string, pos Position) {
+		if first, ok := declared[name]; ok {
+			v.diags.Errorf(pos, "duplicate %s %s (first declared at %s)", kind, name, first)
+			return
+		}
+		declared[name] = pos
+	}
+
+	for _, model := range v.blocks() {
+		declare(string(model.Kind), model.Name, model.Pos)
+	}
+	for _, enum := range v.schema.Enums {
+		declare("enum", enum.Name, enum.Pos)
+	}
+}
+
+// checkMap validates that a @map/@@map attribute has a single non-empty
+// string argument.
+func (v *validator) checkMap(attrs []*Attribute, label string) {
+	attr := findAttribute(attrs, "map")
+	if attr == nil {
+		return
+	}
+
+	if len(attr.Args) != 1 || attr.Arg(0, "name") == nil {
+		v.diags.Errorf(attr.Pos, "%s expects a single string argument", label)
+		return
+	}
+
+	value, ok := StringValue(attr.Arg(0, "name").Value)
+	if !ok {
+		v.diags.Errorf(
+			attr.Args[0].Pos,
+			"%s expects a string argument, got %s",
+			label,
+			attr.Args[0].Value,
+		)
+		return
+	}
+	if value == "" {
+		v.diags.Errorf(attr.Args[0].Pos, "%s name must not be empty", label)
+	}
+}
+
+func (v *validator) checkModelFields(model *Model) {
+	seen := map[string]Position{}
+
+	for _, field := range model.Fields {
+		if first, ok := seen[field.Name]; ok {
+			v.diags.Errorf(
+				field.Pos,
+				"duplicate field %s in %s %s (first declared at %s)",
+				field.Name,
+				model.Kind,
+				model.Name,
+				first,
+			)
+		}
+		seen[field.Name] = field.Pos
+
+		typeName := field.Type.Name
+		switch {
+		case IsScalar(typeName),
+			v.schema.Enum(typeName) != nil,
+			v.schema.Type(typeName) != nil:
+			v.checkMap(field.Attributes, "@map")
+
+		case v.schema.Model(typeName) != nil:
+			if model.Kind == TypeBlock {
+				v.diags.Errorf(
+					field.Type.Pos,
+					"composite type %s cannot reference model %s",
+					model.Name,
+					typeName,
+				)
+				continue
+			}
+			if attr := field.Attribute("map"); attr != nil {
+				v.diags.Errorf(attr.Pos, "@map is not allowed on relation field %s", field.Name)
+			}
+			v.checkRelation(model, field)
+
+		case v.schema.View(typeName) != nil:
+			// Relations to views are not enforced by the database, so their
+			// fields are not checked any further.
+
+		default:
+			v.diags.Errorf(
+				field.Type.Pos,
+				"unknown type %s for field %s in %s %s",
+				typeName,
+				field.Name,
+				model.Kind,
+				model.Name,
+			)
+		}
+	}
+}
+
+// relationName returns the name given to the relation of a field, which is
+// used to pair both sides when two models have more than one relation.
+func relationName(field *Field) string {
+	attr := field.Attribute("relation")
+	if attr == nil {
+		return ""
+	}
+	arg := attr.Arg(0, "name")
+	if arg == nil {
+		return ""
+	}
+	name, _ := StringValue(arg.Value)
+	return name
+}
+
+// relationFields returns the field names listed in the `fields` or
+// `references` argument of a @relation attribute.
+func relationFields(field *Field, argName string) ([]string, *Argument) {
+	attr := field.Attribute("relation")
+	if attr == nil {
+		return nil, nil
+	}
+	arg := attr.Arg(-1, argName)
+	if arg == nil {
+		return nil, nil
+	}
+
+	array, ok := arg.Value.(*ArrayLit)
+	if !ok {
+		return nil, arg
+	}
+
+	names := make([]string, 0, len(array.Elems))
+	for _, elem := range array.Elems {
+		if ident, ok := elem.(*Ident); ok {
+			names = append(names, ident.Name)
+		}
+	}
+	return names, arg
+}
+
+// OppositeRelationFields returns the fields on the other side of a
+// relation, matched by type and relation name. A valid relation has exactly
+// one.
+func (s *Schema) OppositeRelationFields(model *Model, field *Field) []*Field {
+	target := s.Model(field.Type.Name)
+	if target == nil {
+		return nil
+	}
+
+	name := relationName(field)
+	var opposites []*Field
+	for _, candidate := range target.Fields {
+		if candidate == field || candidate.Type.Name != model.Name {
+			continue
+		}
+		if relationName(candidate) != name {
+			continue
+		}
+		opposites = append(opposites, candidate)
+	}
+	return opposites
+}
+
+func (v *validator) checkRelation(model *Model, field *Field) {
+	target := v.schema.Model(field.Type.Name)
+
+	fields, fieldsArg := relationFields(field, "fields")
+	references, referencesArg := relationFields(field, "references")
+
+	for _, check := range []struct {
+		arg    *Argument
+		names  []string
+		model  *Model
+		label  string
+		wanted string
+	}{
+		{fieldsArg, fields, model, "fields", "scalar field"},
+		{referencesArg, references, target, "references", "field"},
+	} {
+		if check.arg == nil {
+			continue
+		}
+		array, ok := check.arg.Value.(*ArrayLit)
+		if !ok || len(array.Elems) != len(check.names) {
+			v.diags.Errorf(
+				check.arg.Pos,
+				"@relation %s expects a list of field names, got %s",
+				check.label,
+				check.arg.Value,
+			)
+			continue
+		}
+		for i, name := range check.names {
+			referenced := check.model.Field(name)
+			if referenced == nil {
+				v.diags.Errorf(
+					array.Elems[i].Position(),
+					"@relation %s references unknown field %s in model %s",
+					check.label,
+					name,
+					check.model.Name,
+				)
+				continue
+			}
+			if v.schema.Model(referenced.Type.Name) != nil {
+				v.diags.Errorf(
+					array.Elems[i].Position(),
+					"@relation %s must reference a %s, but %s.%s is a relation",
+					check.label,
+					check.wanted,
+					check.model.Name,
+					name,
+				)
+			}
+		}
+	}
+
+	if (fieldsArg == nil) != (referencesArg == nil) {
+		v.diags.Errorf(
+			field.Attribute("relation").Pos,
+			"@relation on %s.%s must define both fields and references",
+			model.Name,
+			field.Name,
+		)
+	} else if fieldsArg != nil && len(fields) != len(references) {
+		v.diags.Errorf(
+			fieldsArg.Pos,
+			"@relation on %s.%s has %d fields but %d references",
+			model.Name,
+			field.Name,
+			len(fields),
+			len(references),
+		)
+	}
+
+	opposites := v.schema.OppositeRelationFields(model, field)
+	switch {
+	case len(opposites) == 0:
+		v.diags.Errorf(
+			field.Pos,
+			"relation field %s.%s has no opposite relation field in model %s",
+			model.Name,
+			field.Name,
+			target.Name,
+		)
+		return
+	case len(opposites) > 1:
+		v.diags.Errorf(
+			field.Pos,
+			"relation field %s.%s is ambiguous, %d fields of model %s point back to %s; name the relations with @relation(\"...\")",
+			model.Name,
+			field.Name,
+			len(opposites),
+			target.Name,
+			model.Name,
+		)
+		return
+	}
+
+	opposite := opposites[0]
+	_, oppositeFieldsArg := relationFields(opposite, "fields")
+	if field.Type.List || fieldsArg != nil || oppositeFieldsArg != nil {
+		return
+	}
+
+	// One side of every 1-1 and 1-n relation must hold the foreign key.
+	// Report it once, on the side that should declare it or, for 1-1
+	// relations, on whichever field comes first.
+	if !opposite.Type.List && positionBefore(opposite.Pos, field.Pos) {
+		return
+	}
+	v.diags.Errorf(
+		field.Pos,
+		"relation field %s.%s must define fields and references in @relation",
+		model.Name,
+		field.Name,
+	)
+}
+
+func positionBefore(a, b Position) bool {
+	if a.Filename != b.Filename {
+		return a.Filename < b.Filename
+	}
+	return a.Offset < b.Offset
+}
+
+// checkKeys reports the fields listed in @@id, @@unique and @@index that are
+// not scalar or enum fields of the model.
+func (v *validator) checkKeys(model *Model) {
+	for _, attr := range model.Attributes {
+		if attr.Name != "id" && attr.Name != "unique" && attr.Name != "index" {
+			continue
+		}
+		for _, ref := range keyFieldRefs(attr) {
+			field := model.Field(ref.name)
+			switch {
+			case field == nil:
+				v.diags.Errorf(
+					ref.pos,
+					"@@%s references unknown field %s in %s %s",
+					attr.Name,
+					ref.name,
+					model.Kind,
+					model.Name,
+				)
+			case v.schema.Model(field.Type.Name) != nil:
+				v.diags.Errorf(
+					ref.pos,
+					"@@%s must reference scalar fields, but %s.%s is a relation",
+					attr.Name,
+					model.Name,
+					ref.name,
+				)
+			}
+		}
+	}
+}
+
+func (v *validator) checkUniqueCriteria(model *Model) {
+	// Prisma requires @@ignore on models rows cannot be identified in.
+	if model.Ignored() {
+		return
+	}
+	if model.Attribute("id") != nil || model.Attribute("unique") != nil {
+		return
+	}
+	for _, field := range model.Fields {
+		if field.HasAttribute("id") || field.HasAttribute("unique") {
+			return
+		}
+	}
+	v.diags.Warningf(
+		model.Pos,
+		"model %s has no @id, @@id, @unique or @@unique, rows cannot be identified",
+		model.Name,
+	)
+}
+
+func (v *validator) checkEnumValues(enum *Enum) {
+	if len(enum.Values) == 0 {
+		v.diags.Errorf(enum.Pos, "enum %s must have at least one value", enum.Name)
+	}
+
+	seen := map[string]Position{}
+	mapped := map[string]*EnumValue{}
+	for _, value := range enum.Values {
+		if first, ok := seen[value.Name]; ok {
+			v.diags.Errorf(
+				value.Pos,
+				"duplicate value %s in enum %s (first declared at %s)",
+				value.Name,
+				enum.Name,
+				first,
+			)
+		}
+		seen[value.Name] = value.Pos
+		v.checkMap(value.Attributes, "@map")
+
+		// Values with the same name are reported above already.
+		dbName := value.DBName()
+		if first, ok := mapped[dbName]; ok && first.Name != value.Name {
+			v.diags.Errorf(
+				value.Pos,
+				"value %s in enum %s maps to %q, like %s (declared at %s)",
+				value.Name,
+				enum.Name,
+				dbName,
+				first.Name,
+				first.Pos,
+			)
+		}
+		if _, ok := mapped[dbName]; !ok {
+			mapped[dbName] = value
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func validate(t *testing.T, src string) []string {
+	t.Helper()
+	var diags []string
+	for _, diag := range Validate(mustParse(t, src)).Sorted() {
+		diags = append(diags, diag.Error())
+	}
+	return diags
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "valid schema",
+			src: `model User {
+  id    Int    @id
+  posts Post[]
+}
+
+model Post {
+  id       Int  @id
+  authorId Int
+  author   User @relation(fields: [authorId], references: [id])
+}`,
+		},
+		{
+			name: "duplicate blocks",
+			src: `model User {
+  id Int @id
+}
+
+enum User {
+  A
+}`,
+			want: []string{
+				"schema.prisma:5:1: error: duplicate enum User (first declared at schema.prisma:1:1)",
+			},
+		},
+		{
+			name: "unknown field type",
+			src: `model User {
+  id   Int     @id
+  role Rolee
+}`,
+			want: []string{
+				"schema.prisma:3:8: error: unknown type Rolee for field role in model User",
+			},
+		},
+		{
+			name: "model without unique criteria",
+			src: `model Log {
+  message String
+}`,
+			want: []string{
+				"schema.prisma:1:1: warning: model Log has no @id, @@id, @unique or @@unique, rows cannot be identified",
+			},
+		},
+		{
+			name: "duplicate enum values",
+			src: `enum Role {
+  A
+  A
+}`,
+			want: []string{
+				"schema.prisma:3:3: error: duplicate value A in enum Role (first declared at schema.prisma:2:3)",
+			},
+		},
+		{
+			name: "empty enum",
+			src:  "enum Empty {\n}",
+			want: []string{
+				"schema.prisma:1:1: error: enum Empty must have at least one value",
+			},
+		},
+		{
+			name: "enum values mapped to the same value",
+			src: `enum Status {
+  DONE
+  FINISHED @map("DONE")
+}`,
+			want: []string{
+				`schema.prisma:3:3: error: value FINISHED in enum Status maps to "DONE", like DONE (declared at schema.prisma:2:3)`,
+			},
+		},
+		{
+			name: "relation without opposite field",
+			src: `model User {
+  id Int @id
+}
+
+model Post {
+  id       Int  @id
+  authorId Int
+  author   User @relation(fields: [authorId], references: [id])
+}`,
+			want: []string{
+				"schema.prisma:8:3: error: relation field Post.author has no opposite relation field in model User",
+			},
+		},
+		{
+			name: "relation fields and references mismatch",
+			src: `model User {
+  id    Int    @id
+  posts Post[]
+}
+
+model Post {
+  id       Int  @id
+  authorId Int
+  author   User @relation(fields: [authorId, id], references: [id])
+}`,
+			want: []string{
+				"schema.prisma:9:27: error: @relation on Post.author has 2 fields but 1 references",
+			},
+		},
+		{
+			name: "ambiguous relations",
+			src: `model User {
+  id      Int    @id
+  posts   Post[]
+}
+
+model Post {
+  id         Int  @id
+  authorId   Int
+  author     User @relation(fields: [authorId], references: [id])
+  reviewerId Int
+  reviewer   User @relation(fields: [reviewerId], references: [id])
+}`,
+			want: []string{
+				`schema.prisma:3:3: error: relation field User.posts is ambiguous, 2 fields of model Post point back to User; name the relations with @relation("...")`,
+			},
+		},
+		{
+			name: "relation without foreign key",
+			src: `model User {
+  id      Int      @id
+  profile Profile?
+}
+
+model Profile {
+  id   Int  @id
+  user User
+}`,
+			want: []string{
+				"schema.prisma:3:3: error: relation field User.profile must define fields and references in @relation",
+			},
+		},
+		{
+			name: "invalid @map arguments",
+			src: `model User {
+  id    Int    @id
+  name  String @map("")
+  posts Post[] @map("posts")
+
+  @@map(users)
+}
+
+model Post {
+  id       Int  @id
+  authorId Int
+  author   User @relation(fields: [authorId], references: [idd])
+}`,
+			want: []string{
+				"schema.prisma:3:21: error: @map name must not be empty",
+				"schema.prisma:4:16: error: @map is not allowed on relation field posts",
+				"schema.prisma:6:9: error: @@map expects a string argument, got users",
+				"schema.prisma:12:60: error: @relation references references unknown field idd in model User",
+			},
+		},
+		{
+			name: "invalid @@schema",
+			src: `datasource db {
+  provider = "postgresql"
+  url      = env("DATABASE_URL")
+  schemas  = ["billing", "sales"]
+}
+
+model User {
+  id Int @id
+}
+
+model Invoice {
+  id Int @id
+
+  @@schema("accounting")
+}
+
+model Order {
+  id Int @id
+
+  @@schema("")
+}
+
+enum Role {
+  USER
+
+  @@schema(billing, sales)
+}`,
+			want: []string{
+				"schema.prisma:7:1: error: model User must define its database schema with @@schema, the datasource lists schemas",
+				`schema.prisma:14:12: error: schema "accounting" of model Invoice is not listed in the schemas of the datasource`,
+				`schema.prisma:20:12: error: @@schema expects a non-empty string argument, got ""`,
+				"schema.prisma:26:3: error: @@schema expects a single string argument",
+			},
+		},
+		{
+			name: "annotations",
+			src: `/// @go.name(type)
+model User {
+  /// @go.omit
+  /// @go.omit
+  id Int @id
+  /// @go.json(x)
+  name String
+}`,
+			want: []string{
+				"schema.prisma:2:1: error: argument type of annotation @go.name on model User must be a valid Go identifier",
+				"schema.prisma:5:3: error: duplicate annotation @go.omit on field User.id",
+				"schema.prisma:7:3: error: unknown annotation @go.json on field User.name",
+			},
+		},
+		{
+			name: "unknown key fields",
+			src: `model User {
+  a Int
+  b Int
+
+  @@id([a, c])
+  @@index([b(sort: Desc), d])
+}`,
+			want: []string{
+				"schema.prisma:5:12: error: @@id references unknown field c in model User",
+				"schema.prisma:6:27: error: @@index references unknown field d in model User",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validate(t, tt.src)
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Validate() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestOppositeRelationFields(t *testing.T) {
+	s := mustParse(t, `model User {
+  id       Int    @id
+  posts    Post[] @relation("Author")
+  reviews  Post[] @relation("Reviewer")
+}
+
+model Post {
+  id         Int  @id
+  authorId   Int
+  author     User @relation("Author", fields: [authorId], references: [id])
+  reviewerId Int
+  reviewer   User @relation("Reviewer", fields: [reviewerId], references: [id])
+}`)
+	user, post := s.Model("User"), s.Model("Post")
+
+	tests := []struct {
+		model *Model
+		field string
+		want  []string
+	}{
+		{user, "posts", []string{"author"}},
+		{user, "reviews", []string{"reviewer"}},
+		{post, "author", []string{"posts"}},
+		{post, "reviewer", []string{"reviews"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.model.Name+"."+tt.field, func(t *testing.T) {
+			var got []string
+			for _, field := range s.OppositeRelationFields(tt.model, tt.model.Field(tt.field)) {
+				got = append(got, field.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OppositeRelationFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiagnostics(t *testing.T) {
+	diags := Diagnostics{}
+	diags.Warningf(Position{Filename: "b.prisma", Offset: 10, Line: 2, Column: 3}, "model %s has no id", "Log")
+	diags.Errorf(Position{Filename: "a.prisma", Offset: 20, Line: 3, Column: 1}, "duplicate model %s", "User")
+	diags.Errorf(Position{Offset: 5, Line: 1, Column: 6}, "unknown type %s", "Foo")
+
+	if !diags.HasErrors() {
+		t.Error("HasErrors() = false, want true")
+	}
+	if got := len(diags.Errors()); got != 2 {
+		t.Errorf("len(Errors()) = %d, want 2", got)
+	}
+	if Diagnostics(diags[:1]).HasErrors() {
+		t.Error("HasErrors() of a warning = true, want false")
+	}
+
+	want := strings.Join([]string{
+		"<input>:1:6: error: unknown type Foo",
+		"a.prisma:3:1: error: duplicate model User",
+		"b.prisma:2:3: warning: model Log has no id",
+	}, "\n")
+	if got := diags.Sorted().Error(); got != want {
+		t.Errorf("Sorted().Error() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -1,39 +1,30 @@
 package usecase
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
+	"slices"
 	"strings"
 	"time"
-)
-
-// Model represents a Prisma model and its fields.
-type Model struct {
-	ModelName string
-	TableName string
-	Fields    []Field
-}
 
-// Field represents a single field in a Prisma model.
-type Field struct {
-	FieldName    string
-	ColumnName   string
-	HasUpdatedAt bool
-}
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
 
+// CreateUpdatedAtTriggers creates a migration with an updated_at trigger for
+// every table with @updatedAt columns that has none yet. Ignored models and
+// fields are skipped unless includeIgnored is set.
 func CreateUpdatedAtTriggers(
 	schemaPath string,
+	includeIgnored bool,
 ) ([]string, error) {
-	migrationsDir := filepath.Join(filepath.Dir(schemaPath), "migrations")
-
-	models, err := parseSchemaPrisma(schemaPath)
+	prismaSchema, err := loadSQLSchema(schemaPath, includeIgnored)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing schema.prisma: %w", err)
 	}
 
+	migrationsDir := prismaSchema.MigrationsDir()
+
 	existsUpdatedAtTriggersByTableName, err := findExistingUpdatedAtTriggersByTableName(
 		migrationsDir,
 	)
@@ -44,34 +35,57 @@ func CreateUpdatedAtTriggers(
 		)
 	}
 
-	migrationFiles := []string{}
-	for _, model := range models {
-		var updatedAtCols []string
-		for _, field := range model.Fields {
-			if field.HasUpdatedAt {
-				updatedAtCols = append(updatedAtCols, field.ColumnName)
-			}
-		}
+	// Migrations named after a bare table name can only be matched when no
+	// other schema has a table with the same name.
+	tableModels := map[string]int{}
+	for _, model := range prismaSchema.Models {
+		tableModels[model.DBName()]++
+	}
 
+	migrationFiles := []string{}
+	for _, model := range prismaSchema.Models {
+		updatedAtCols := updatedAtColumns(model)
 		if len(updatedAtCols) == 0 {
 			continue
 		}
 
-		if _, ok := existsUpdatedAtTriggersByTableName[model.TableName]; ok {
+		// Triggers of tables in a @@schema are named schema_table, so tables
+		// with the same name in different schemas get their own triggers.
+		// Migrations named after the bare table still count as existing
+		// triggers.
+		tableName := model.DBName()
+		triggerName := tableName
+		var names []string
+		if schemaName := model.SchemaName(); schemaName != "" {
+			triggerName = schemaName + "_" + tableName
+			names = append(names, triggerName)
+		}
+		if triggerName == tableName || tableModels[tableName] == 1 {
+			names = append(names, tableName)
+		}
+		if slices.ContainsFunc(names, func(name string) bool {
+			_, ok := existsUpdatedAtTriggersByTableName[name]
+			return ok
+		}) {
 			continue
 		}
 
-		triggerSQL := generateTriggerSQL(model.TableName, updatedAtCols)
+		triggerSQL := generateTriggerSQL(
+			model.SchemaName(),
+			tableName,
+			triggerName,
+			updatedAtCols,
+		)
 
 		migrationFile, err := createNewMigrationFile(
 			migrationsDir,
-			model.TableName,
+			triggerName,
 			triggerSQL,
 		)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error creating new migration for table %s: %w",
-				model.TableName,
+				tableName,
 				err,
 			)
 		}
@@ -82,105 +96,16 @@ func CreateUpdatedAtTriggers(
 	return migrationFiles, nil
 }
 
-// parseSchemaPrisma reads the `schema.prisma` file and extracts:
-//   - model name
-//   - table name (@@map if present, otherwise model name)
-//   - fields that have @updatedAt
-//   - column name (@map if present, otherwise field name)
-func parseSchemaPrisma(schemaPath string) ([]Model, error) {
-	file, err := os.Open(schemaPath)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	var models []Model
-
-	scanner := bufio.NewScanner(file)
-
-	var currentModel *Model
-	var inModelBlock bool
-
-	// Regex to match lines like:
-	// model User {
-	// model SomethingElse {
-	modelStartRegex := regexp.MustCompile(`^model\s+(\w+)\s+\{`)
-
-	// Regex to match lines like:
-	// @@map("users")
-	mapTableRegex := regexp.MustCompile(`@@map\("([^"]+)"\)`)
-
-	// Regex to match fields, e.g.:
-	// updatedAt     DateTime   @updatedAt @map("updated_at")
-	// lastModified DateTime   @updatedAt
-	fieldRegex := regexp.MustCompile(`^(\w+)\s+(\w+)\s+(.+)$`)
-
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-
-		if matches := modelStartRegex.FindStringSubmatch(line); len(
-			matches,
-		) == 2 {
-			inModelBlock = true
-			currentModel = &Model{
-				ModelName: matches[1],
-				TableName: matches[1],
-			}
-			continue
-		}
-
-		if inModelBlock && strings.HasPrefix(line, "}") {
-			if currentModel != nil {
-				models = append(models, *currentModel)
-				currentModel = nil
-			}
-			inModelBlock = false
-			continue
-		}
-
-		if inModelBlock && currentModel != nil {
-			if mapMatches := mapTableRegex.FindStringSubmatch(line); len(
-				mapMatches,
-			) == 2 {
-				currentModel.TableName = mapMatches[1]
-				continue
-			}
-
-			if fieldMatches := fieldRegex.FindStringSubmatch(line); len(
-				fieldMatches,
-			) == 4 {
-				fieldName := fieldMatches[1]
-				// fieldType := fieldMatches[2]
-				fieldAttrs := fieldMatches[3]
-
-				hasUpdatedAt := strings.Contains(fieldAttrs, "@updatedAt")
-
-				var columnName string
-				columnMapRegex := regexp.MustCompile(`@map\("([^"]+)"\)`)
-				if colMapMatch := columnMapRegex.FindStringSubmatch(fieldAttrs); len(
-					colMapMatch,
-				) == 2 {
-					columnName = colMapMatch[1]
-				} else {
-					columnName = fieldName
-				}
-
-				if hasUpdatedAt {
-					currentModel.Fields = append(currentModel.Fields, Field{
-						FieldName:    fieldName,
-						ColumnName:   columnName,
-						HasUpdatedAt: true,
-					})
-				}
-			}
+// updatedAtColumns returns the column names (@map if present, otherwise
+// field name) of the fields that have @updatedAt.
+func updatedAtColumns(model *schema.Model) []string {
+	var columns []string
+	for _, field := range model.Fields {
+		if field.HasAttribute("updatedAt") {
+			columns = append(columns, field.DBName())
 		}
 	}
-
-	if err := scanner.Err(); err != nil {
-		return nil, err
-	}
-
-	return models, nil
+	return columns
 }
 
 // findExistingUpdatedAtTriggersByTableName scans the prisma/migrations folder
@@ -218,15 +143,22 @@ func findExistingUpdatedAtTriggersByTableName(
 // generateTriggerSQL generates a block of SQL that creates a trigger to auto-update
 // any @updatedAt column in the specified table. Typically, you only need one
 // trigger function per table that sets all the @updatedAt columns to NOW().
-func generateTriggerSQL(tableName string, columns []string) string {
+// When schemaName is set, the table and the trigger function are qualified
+// with it. The trigger and its function are named after triggerName.
+func generateTriggerSQL(schemaName, tableName, triggerName string, columns []string) string {
 	setClauses := make([]string, 0, len(columns))
 	for _, col := range columns {
 		setClauses = append(setClauses, fmt.Sprintf("NEW.\"%s\" = now();", col))
 	}
 
+	qualifier := ""
+	if schemaName != "" {
+		qualifier = fmt.Sprintf("\"%s\".", schemaName)
+	}
+
 	return fmt.Sprintf(`
--- Auto-generated trigger for table "%[1]s" to update @updatedAt columns
-CREATE OR REPLACE FUNCTION "%[1]s_updated_at_trigger"()
+-- Auto-generated trigger for table %[3]s"%[1]s" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION %[3]s"%[4]s_updated_at_trigger"()
 RETURNS TRIGGER AS $$
 BEGIN
     %[2]s
@@ -234,18 +166,18 @@ BEGIN
 END;
 $$ language 'plpgsql';
 
-CREATE TRIGGER "%[1]s_updated_at_trigger"
-BEFORE UPDATE ON "%[1]s"
+CREATE TRIGGER "%[4]s_updated_at_trigger"
+BEFORE UPDATE ON %[3]s"%[1]s"
 FOR EACH ROW
-EXECUTE PROCEDURE "%[1]s_updated_at_trigger"();
-`, tableName, strings.Join(setClauses, "\n    "))
+EXECUTE PROCEDURE %[3]s"%[4]s_updated_at_trigger"();
+`, tableName, strings.Join(setClauses, "\n    "), qualifier, triggerName)
 }
 
 func createNewMigrationFile(
-	migrationsDir, tableName, sqlStmt string,
+	migrationsDir, triggerName, sqlStmt string,
 ) (string, error) {
 	timestamp := time.Now().Add(time.Second).Format("20060102150405")
-	migrationName := fmt.Sprintf("%s_updated_at_%s", timestamp, tableName)
+	migrationName := fmt.Sprintf("%s_updated_at_%s", timestamp, triggerName)
 	migrationFolder := filepath.Join(migrationsDir, migrationName)
 
 	if err := os.MkdirAll(migrationFolder, 0o755); err != nil {
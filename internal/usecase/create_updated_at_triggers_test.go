@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"cmp"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var migrationTimestampRegex = regexp.MustCompile(`^\d{14}_`)
+
+func TestCreateUpdatedAtTriggers(t *testing.T) {
+	tests := []struct {
+		name string
+		// out is the golden directory of the migrations of the test case,
+		// migrations by default.
+		out            string
+		includeIgnored bool
+	}{
+		{name: "updated_at_triggers"},
+		{name: "updated_at_triggers_schemas"},
+		{name: "views"},
+		{name: "ignore"},
+		{name: "ignore", out: "migrations_include_ignored", includeIgnored: true},
+	}
+
+	for _, tt := range tests {
+		out := cmp.Or(tt.out, "migrations")
+		t.Run(tt.name+"/"+out, func(t *testing.T) {
+			tc := newTestCase(t, tt.name)
+			paths, err := CreateUpdatedAtTriggers(tc.schemaPath, tt.includeIgnored)
+			if err != nil {
+				t.Fatalf("CreateUpdatedAtTriggers() error = %v", err)
+			}
+
+			// The migrations are named after the time they are created at
+			files := map[string][]byte{}
+			for path, content := range readFiles(t, filepath.Join(tc.dir, "prisma", "migrations"), paths) {
+				files[migrationTimestampRegex.ReplaceAllString(path, "")] = content
+			}
+			checkGolden(t, filepath.Join("testdata", tt.name, out), files)
+		})
+	}
+}
+
+func TestCreateUpdatedAtTriggersInvalidSchema(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string][]byte{
+		"schema.prisma":         []byte("model Post {\n  id        Int @id\n  updatedAt Date @updatedAt\n}\n"),
+		"migrations/.gitignore": nil,
+	})
+
+	_, err := CreateUpdatedAtTriggers(filepath.Join(dir, "schema.prisma"), false)
+	want := "schema.prisma:3:13: error: unknown type Date for field updatedAt in model Post"
+	if err == nil || !strings.HasSuffix(err.Error(), want) {
+		t.Errorf("CreateUpdatedAtTriggers() error = %v, want %s", err, want)
+	}
+	if files := listFiles(t, filepath.Join(dir, "migrations")); len(files) != 1 {
+		t.Errorf("migrations = %q, want none created", files)
+	}
+}
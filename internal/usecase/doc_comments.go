@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// goDocComment renders the /// doc comment of a schema element as a Go doc
+// comment indented by indent. A "/// @deprecated reason" line becomes a
+// "Deprecated:" paragraph, which linters like staticcheck understand, and
+// @go.* annotations are left out.
+func goDocComment(name, doc, indent string) string {
+	if doc == "" {
+		return ""
+	}
+
+	var lines []string
+	deprecated, isDeprecated := "", false
+	for _, line := range strings.Split(doc, "\n") {
+		if schema.IsAnnotation(line) {
+			continue
+		}
+		reason, ok := strings.CutPrefix(strings.TrimSpace(line), "@deprecated")
+		if ok && (reason == "" || reason[0] == ' ' || reason[0] == '\t') {
+			deprecated, isDeprecated = strings.TrimSpace(reason), true
+			continue
+		}
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if isDeprecated {
+		if deprecated == "" {
+			deprecated = name + " should no longer be used."
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "Deprecated: "+deprecated)
+	}
+
+	var comment strings.Builder
+	for _, line := range lines {
+		comment.WriteString(indent + "//")
+		if line != "" {
+			comment.WriteString(" " + line)
+		}
+		comment.WriteString("\n")
+	}
+	return comment.String()
+}
+
+// wrapComment renders text as // comment lines of at most 80 columns,
+// breaking it between words.
+func wrapComment(text string) string {
+	var comment strings.Builder
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 80 && line != "//" {
+			comment.WriteString(line + "\n")
+			line = "//"
+		}
+		line += " " + word
+	}
+	comment.WriteString(line + "\n")
+	return comment.String()
+}
@@ -0,0 +1,384 @@
+package usecase
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// databaseDefaults are the @default functions evaluated by the database,
+// which the constructors leave unset.
+var databaseDefaults = []string{"autoincrement", "dbgenerated", "sequence", "auto"}
+
+// nullValueFields maps the database/sql and pgtype nullable types to the
+// field holding their value and its Go type.
+var nullValueFields = map[string]struct{ field, goType string }{
+	"sql.NullBool":        {"Bool", "bool"},
+	"sql.NullByte":        {"Byte", "byte"},
+	"sql.NullFloat64":     {"Float64", "float64"},
+	"sql.NullInt16":       {"Int16", "int16"},
+	"sql.NullInt32":       {"Int32", "int32"},
+	"sql.NullInt64":       {"Int64", "int64"},
+	"sql.NullString":      {"String", "string"},
+	"sql.NullTime":        {"Time", "time.Time"},
+	"decimal.NullDecimal": {"Decimal", "decimal.Decimal"},
+	"pgtype.Bool":         {"Bool", "bool"},
+	"pgtype.Float4":       {"Float32", "float32"},
+	"pgtype.Float8":       {"Float64", "float64"},
+	"pgtype.Int2":         {"Int16", "int16"},
+	"pgtype.Int4":         {"Int32", "int32"},
+	"pgtype.Int8":         {"Int64", "int64"},
+	"pgtype.Text":         {"String", "string"},
+	"pgtype.Timestamptz":  {"Time", "time.Time"},
+	"pgtype.Timestamp":    {"Time", "time.Time"},
+	"pgtype.Date":         {"Time", "time.Time"},
+	"pgtype.Uint32":       {"Uint32", "uint32"},
+	"pgtype.UUID":         {"Bytes", "uuid.UUID"},
+}
+
+var stringLitRegex = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// isDatabaseDefault reports whether the database sets the default value of
+// a field, e.g. with @default(autoincrement()).
+func isDatabaseDefault(field *schema.Field) bool {
+	attr := field.Attribute("default")
+	if attr == nil {
+		return false
+	}
+	arg := attr.Arg(0, "value")
+	if arg == nil {
+		return false
+	}
+	call, ok := arg.Value.(*schema.FuncCall)
+	return ok && slices.Contains(databaseDefaults, call.Name)
+}
+
+// defaultValues builds the Go expressions of the @default values applied by
+// a constructor.
+type defaultValues struct {
+	resolver typeResolver
+	imports  goImports
+	helpers  goHelpers
+	mode     NullableMode
+	// now reports whether an expression uses the now variable, holding the
+	// time the constructor is called at.
+	now bool
+}
+
+// field returns the Go expression of the default value of a field, of its
+// struct field type, and whether it has one the constructor can apply.
+// @updatedAt fields default to the current time, like in the Prisma Client.
+func (d *defaultValues) field(field *schema.Field, fieldType goFieldType) (string, bool) {
+	var value schema.Expr
+	if attr := field.Attribute("default"); attr != nil {
+		if arg := attr.Arg(0, "value"); arg != nil {
+			value = arg.Value
+		}
+	} else if field.HasAttribute("updatedAt") {
+		value = &schema.FuncCall{Name: "now"}
+	}
+	if value == nil || isDatabaseDefault(field) {
+		return "", false
+	}
+
+	enum := d.resolver.schema.Enum(field.Type.Name)
+
+	if field.Type.List {
+		array, ok := value.(*schema.ArrayLit)
+		if !ok {
+			return "", false
+		}
+		elems := make([]string, 0, len(array.Elems))
+		for _, elem := range array.Elems {
+			expr, ok := d.value(elem, fieldType.base, enum)
+			if !ok {
+				return "", false
+			}
+			elems = append(elems, expr)
+		}
+		return "[]" + fieldType.base + "{" + strings.Join(elems, ", ") + "}", true
+	}
+
+	expr, ok := d.value(value, cmp.Or(fieldType.underlying, fieldType.base), enum)
+	if !ok {
+		return "", false
+	}
+	if fieldType.underlying != "" {
+		expr = fieldType.base + "(" + expr + ")"
+	}
+	if field.Type.Optional {
+		return d.nullable(expr, fieldType)
+	}
+	return expr, true
+}
+
+// nullable wraps the expression of a value in the nullable type of the
+// field.
+func (d *defaultValues) nullable(expr string, fieldType goFieldType) (string, bool) {
+	if fieldType.nullable != "" {
+		return "", false
+	}
+
+	nullType := nullableType(fieldType, d.mode)
+	switch {
+	case nullType == fieldType.base:
+		return expr, true
+	case strings.HasPrefix(nullType, "*"):
+		d.helpers.add("ptr")
+		return "ptr(" + expr + ")", true
+	case strings.HasPrefix(nullType, "Null["):
+		return "NewNull(" + expr + ")", true
+	case strings.HasPrefix(nullType, "sql.Null["):
+		return nullType + "{V: " + expr + ", Valid: true}", true
+	case nullType == fieldType.sqlNull:
+		// The Null companion of an enum, e.g. NullRole{Role: v}.
+		name := fieldType.base[strings.LastIndex(fieldType.base, ".")+1:]
+		return fmt.Sprintf("%s{%s: %s, Valid: true}", nullType, name, expr), true
+	}
+
+	value, ok := nullValueFields[nullType]
+	if !ok {
+		return "", false
+	}
+	switch {
+	case fieldType.base == value.goType:
+	case fieldType.base == "Date" && value.goType == "time.Time":
+		expr += ".Time()"
+	case fieldType.base == "int" && value.goType == "int64":
+		expr = "int64(" + expr + ")"
+	default:
+		return "", false
+	}
+	return fmt.Sprintf("%s{%s: %s, Valid: true}", nullType, value.field, expr), true
+}
+
+// value returns the Go expression of a default value of type base. Number
+// literals are converted to base unless it is their default type, so the
+// expression can be wrapped with type inference, e.g. in ptr(v).
+func (d *defaultValues) value(value schema.Expr, base string, enum *schema.Enum) (string, bool) {
+	expr, ok := d.literal(value, base, enum)
+	if !ok {
+		return "", false
+	}
+	d.imports.addType(stringLitRegex.ReplaceAllString(expr, `""`), d.resolver.packages)
+	d.helpers.addType(expr)
+	return expr, true
+}
+
+func (d *defaultValues) literal(value schema.Expr, base string, enum *schema.Enum) (string, bool) {
+	switch v := value.(type) {
+	case *schema.StringLit:
+		quoted := strconv.Quote(v.Value)
+		switch base {
+		case "string":
+			return quoted, true
+		case "json.RawMessage":
+			return "json.RawMessage(" + quoted + ")", true
+		case "uuid.UUID":
+			return "uuid.MustParse(" + quoted + ")", true
+		case "decimal.Decimal":
+			return "decimal.RequireFromString(" + quoted + ")", true
+		}
+		// Decimal defaults may be written as strings, e.g. @default("1.50").
+		if _, err := strconv.ParseFloat(v.Value, 64); err == nil {
+			return d.literal(&schema.NumberLit{Value: v.Value}, base, enum)
+		}
+
+	case *schema.NumberLit:
+		integer := !strings.ContainsAny(v.Value, ".eE")
+		switch base {
+		case "int":
+			return v.Value, integer
+		case "float64":
+			if integer {
+				return "float64(" + v.Value + ")", true
+			}
+			return v.Value, true
+		case "float32":
+			return "float32(" + v.Value + ")", true
+		case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+			return base + "(" + v.Value + ")", integer
+		case "string":
+			// Decimal fields in string mode.
+			return strconv.Quote(v.Value), true
+		case "decimal.Decimal":
+			return "decimal.RequireFromString(" + strconv.Quote(v.Value) + ")", true
+		}
+
+	case *schema.Ident:
+		if base == "bool" && (v.Name == "true" || v.Name == "false") {
+			return v.Name, true
+		}
+		if enum == nil {
+			return "", false
+		}
+		for _, enumValue := range enum.Values {
+			if enumValue.Name == v.Name {
+				// Keep the package qualifier of enums of other packages.
+				qualifier := strings.TrimSuffix(base, d.resolver.names.enum(enum))
+				return qualifier + d.resolver.names.enumValue(enum, enumValue), true
+			}
+		}
+
+	case *schema.FuncCall:
+		return d.call(v, base)
+	}
+
+	return "", false
+}
+
+// call returns the Go expression of a @default function evaluated by the
+// application.
+func (d *defaultValues) call(call *schema.FuncCall, base string) (string, bool) {
+	version := ""
+	if arg := call.Arg(0, ""); arg != nil {
+		if number, ok := arg.Value.(*schema.NumberLit); ok {
+			version = number.Value
+		}
+	}
+
+	switch call.Name {
+	case "now":
+		d.now = true
+		switch base {
+		case "time.Time":
+			return "now", true
+		case "Date":
+			return "NewDate(now)", true
+		case "TimeOfDay":
+			return "NewTimeOfDay(now)", true
+		}
+
+	case "uuid":
+		switch {
+		case base == "uuid.UUID" && version == "7":
+			return "uuid.Must(uuid.NewV7())", true
+		case base == "uuid.UUID":
+			return "uuid.New()", true
+		case base == "string" && version == "7":
+			return "uuid.Must(uuid.NewV7()).String()", true
+		case base == "string":
+			return "uuid.NewString()", true
+		}
+
+	case "cuid":
+		if base != "string" {
+			return "", false
+		}
+		if version == "2" {
+			d.helpers.add("newCUID2")
+			return "newCUID2()", true
+		}
+		d.helpers.add("newCUID")
+		return "newCUID()", true
+
+	case "nanoid":
+		if base != "string" {
+			return "", false
+		}
+		d.helpers.add("newNanoID")
+		return "newNanoID(" + cmp.Or(version, "21") + ")", true
+
+	case "ulid":
+		if base != "string" {
+			return "", false
+		}
+		d.helpers.add("newULID")
+		return "newULID()", true
+	}
+
+	return "", false
+}
+
+// parseConstructor returns the New<Model> constructor of a model, applying
+// the default values set by the application, and the DatabaseDefaults method
+// listing the columns whose default is set by the database.
+func parseConstructor(
+	model *schema.Model,
+	resolver typeResolver,
+	imports goImports,
+	helpers goHelpers,
+	opts EntitiesOptions,
+) string {
+	modelName := resolver.names.model(model)
+	defaults := &defaultValues{
+		resolver: resolver,
+		imports:  imports,
+		helpers:  helpers,
+		mode:     opts.Nullable,
+	}
+
+	var values, unsupported, databaseColumns []string
+	for _, field := range model.Fields {
+		fieldType, ok := resolver.entityType(field)
+		if !ok {
+			continue
+		}
+		if isDatabaseDefault(field) {
+			databaseColumns = append(databaseColumns, field.DBName())
+			continue
+		}
+		if !field.HasAttribute("default") && !field.HasAttribute("updatedAt") {
+			continue
+		}
+
+		fieldName := resolver.names.field(field)
+		expr, ok := defaults.field(field, fieldType)
+		if !ok {
+			unsupported = append(unsupported, fieldName)
+			continue
+		}
+		values = append(values, fmt.Sprintf("\t\t%s: %s,\n", fieldName, expr))
+	}
+
+	var doc strings.Builder
+	doc.WriteString(fmt.Sprintf("// New%[1]s returns a new %[1]s value holding the default values of its\n// fields.\n", modelName))
+	if len(databaseColumns) > 0 || len(unsupported) > 0 {
+		doc.WriteString("//\n")
+	}
+	if len(databaseColumns) > 0 {
+		doc.WriteString("// The columns listed by DatabaseDefaults are left unset.\n")
+	}
+	switch len(unsupported) {
+	case 0:
+	case 1:
+		doc.WriteString(wrapComment(fmt.Sprintf(
+			"The default of %s is not applied because its Go type is not supported.",
+			unsupported[0],
+		)))
+	default:
+		doc.WriteString(wrapComment(fmt.Sprintf(
+			"The defaults of %s and %s are not applied because their Go type is not supported.",
+			strings.Join(unsupported[:len(unsupported)-1], ", "),
+			unsupported[len(unsupported)-1],
+		)))
+	}
+
+	var body strings.Builder
+	if defaults.now {
+		imports.add("time")
+		body.WriteString("\tnow := time.Now()\n")
+	}
+	if len(values) == 0 {
+		body.WriteString(fmt.Sprintf("\treturn %s{}\n", modelName))
+	} else {
+		body.WriteString(fmt.Sprintf("\treturn %s{\n%s\t}\n", modelName, strings.Join(values, "")))
+	}
+
+	return fmt.Sprintf(
+		"%[2]sfunc New%[1]s() %[1]s {\n%[3]s}\n\n"+
+			"// DatabaseDefaults returns the columns of the table %[4]q whose default\n"+
+			"// value is set by the database, e.g. with autoincrement().\n"+
+			"func (%[1]s) DatabaseDefaults() []string {\n\treturn %[5]s\n}",
+		modelName,
+		doc.String(),
+		body.String(),
+		model.DBName(),
+		goStrings("[]string", databaseColumns),
+	)
+}
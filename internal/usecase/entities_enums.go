@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// enumImports lists the imports used by the generated enum methods.
+var enumImports = []string{"database/sql/driver", "encoding/json", "fmt"}
+
+// enumIdentifiers returns the package level identifiers generated for an
+// enum, besides its constants.
+func enumIdentifiers(enum *schema.Enum, names goNames) []string {
+	name := names.enum(enum)
+	return []string{name, "Parse" + name, "Null" + name}
+}
+
+// Parse a Prisma enum into a Go type
+func parseEnum(enum *schema.Enum, names goNames, imports goImports) string {
+	enumName := names.enum(enum)
+	constants := make([]string, 0, len(enum.Values))
+
+	// Generate Go enum type and constants
+	var enumDef strings.Builder
+	enumDef.WriteString(goDocComment(enumName, enum.Doc, ""))
+	enumDef.WriteString(fmt.Sprintf("type %s string\n\nconst (\n", enumName))
+	for _, value := range enum.Values {
+		constant := names.enumValue(enum, value)
+		constants = append(constants, constant)
+		enumDef.WriteString(goDocComment(constant, value.Doc, "\t"))
+		enumDef.WriteString(
+			fmt.Sprintf(
+				"\t%s %s = \"%s\"\n",
+				constant,
+				enumName,
+				value.DBName(),
+			),
+		)
+	}
+	enumDef.WriteString(")\n\n")
+
+	enumDef.WriteString(fmt.Sprintf(
+		enumMethods,
+		enumName,
+		strings.Join(constants, ", "),
+		enum.DBName(),
+	))
+
+	for _, path := range enumImports {
+		imports.add(path)
+	}
+
+	return enumDef.String()
+}
+
+// enumMethods is the format of the methods generated for every enum and of
+// its Null companion, taking the enum name, the comma-separated list of its
+// constants and the name of the database enum type.
+const enumMethods = `// TypeName returns the name of the database enum type of %[1]s.
+func (%[1]s) TypeName() string {
+	return %[3]q
+}
+
+// Values returns all the values of %[1]s.
+func (%[1]s) Values() []%[1]s {
+	return []%[1]s{%[2]s}
+}
+
+// IsValid reports whether e is one of the values of %[1]s.
+func (e %[1]s) IsValid() bool {
+	switch e {
+	case %[2]s:
+		return true
+	}
+	return false
+}
+
+// String returns the value of e.
+func (e %[1]s) String() string {
+	return string(e)
+}
+
+// Parse%[1]s returns the %[1]s with the given value, or an error if it is
+// not one of its values.
+func Parse%[1]s(value string) (%[1]s, error) {
+	if e := %[1]s(value); e.IsValid() {
+		return e, nil
+	}
+	return "", fmt.Errorf("invalid %[1]s value %%q", value)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e %[1]s) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *%[1]s) UnmarshalText(text []byte) error {
+	value, err := Parse%[1]s(string(text))
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *%[1]s) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case []byte:
+		return e.UnmarshalText(v)
+	case nil:
+		return fmt.Errorf("cannot scan NULL into %[1]s, use Null%[1]s")
+	}
+	return fmt.Errorf("cannot scan %%T into %[1]s", value)
+}
+
+// Value implements the driver.Valuer interface.
+func (e %[1]s) Value() (driver.Value, error) {
+	if !e.IsValid() {
+		return nil, fmt.Errorf("invalid %[1]s value %%q", string(e))
+	}
+	return string(e), nil
+}
+
+// Null%[1]s represents a %[1]s that may be NULL.
+type Null%[1]s struct {
+	%[1]s %[1]s
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *Null%[1]s) Scan(value any) error {
+	if value == nil {
+		*n = Null%[1]s{}
+		return nil
+	}
+	if err := n.%[1]s.Scan(value); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n Null%[1]s) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.%[1]s.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n Null%[1]s) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.%[1]s)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *Null%[1]s) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = Null%[1]s{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.%[1]s); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+`
@@ -0,0 +1,580 @@
+package usecase
+
+import (
+	"go/token"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// goHelper is a type or function generated in the entities package when a
+// field needs it, with the imports its source uses.
+type goHelper struct {
+	name    string
+	source  string
+	imports []string
+}
+
+// entityHelpers lists the helper types in the order they are generated.
+var entityHelpers = []goHelper{
+	{
+		name:    "Null",
+		source:  genericNullType,
+		imports: []string{"database/sql", "database/sql/driver", "encoding/json"},
+	},
+	{
+		name:    "Date",
+		source:  dateType,
+		imports: []string{"database/sql/driver", "fmt", "time"},
+	},
+	{
+		name:    "TimeOfDay",
+		source:  timeOfDayType,
+		imports: []string{"database/sql/driver", "fmt", "time"},
+	},
+	{
+		name:    "Inet",
+		source:  inetType,
+		imports: []string{"database/sql/driver", "errors", "fmt", "net/netip", "strings"},
+	},
+	{
+		name:   "Relation",
+		source: relationType,
+	},
+	{
+		name:   "ptr",
+		source: ptrFunc,
+	},
+	{
+		name:    "scanID",
+		source:  scanIDFunc,
+		imports: []string{"database/sql", "errors"},
+	},
+	{
+		name:    "newCUID",
+		source:  cuidFunc,
+		imports: []string{"crypto/rand", "encoding/binary", "os", "strconv", "strings", "sync/atomic", "time"},
+	},
+	{
+		name:    "newCUID2",
+		source:  cuid2Func,
+		imports: []string{"crypto/rand", "crypto/sha512", "fmt", "math/big", "sync/atomic", "time"},
+	},
+	{
+		name:    "newNanoID",
+		source:  nanoidFunc,
+		imports: []string{"crypto/rand"},
+	},
+	{
+		name:    "newULID",
+		source:  ulidFunc,
+		imports: []string{"crypto/rand", "encoding/binary", "time"},
+	},
+}
+
+var helperRegex = regexp.MustCompile(`(?:^|[^.\w])(Null|Date|TimeOfDay|Inet)\b`)
+
+// helperIdentifiers maps the helpers to the identifiers they declare.
+var helperIdentifiers = map[string][]string{
+	"Null":      {"Null", "NewNull"},
+	"Date":      {"Date", "NewDate"},
+	"TimeOfDay": {"TimeOfDay", "NewTimeOfDay"},
+	"Inet":      {"Inet", "ParseInet"},
+	"Relation":  relationIdentifiers,
+	"ptr":       {"ptr"},
+	"scanID":    {"scanID"},
+	"newCUID":   {"newCUID", "cuidCounter", "cuidFingerprint", "cuidBlock"},
+	"newCUID2":  {"newCUID2", "cuid2Counter"},
+	"newNanoID": {"newNanoID", "nanoidAlphabet"},
+	"newULID":   {"newULID", "ulidAlphabet"},
+}
+
+// fieldHelpers returns the helper types the scalar fields of s are generated
+// with, and the helper functions, which may be needed by any default value
+// or ID type. Being unexported, the functions only collide with explicit
+// @go.name annotations.
+func fieldHelpers(s *schema.Schema, resolver typeResolver, nullable NullableMode) goHelpers {
+	helpers := goHelpers{}
+	for _, helper := range entityHelpers {
+		if !token.IsExported(helper.name) {
+			helpers.add(helper.name)
+		}
+	}
+	if nullable == NullableGeneric {
+		helpers.add("Null")
+	}
+	for _, model := range slices.Concat(s.Types, s.Models, s.Views) {
+		for _, field := range model.Fields {
+			if resolver.kind(field) != scalarField {
+				continue
+			}
+			if fieldType, ok := resolver.goType(field); ok {
+				helpers.addType(structFieldType(field, fieldType, nullable))
+				helpers.addType(fieldType.underlying)
+			}
+		}
+	}
+	return helpers
+}
+
+// goHelpers collects the helper types referenced by generated code.
+type goHelpers map[string]struct{}
+
+func (h goHelpers) add(name string) {
+	h[name] = struct{}{}
+}
+
+// identifiers returns the identifiers declared by the collected helpers.
+func (h goHelpers) identifiers() []string {
+	var idents []string
+	for _, name := range slices.Sorted(maps.Keys(h)) {
+		idents = append(idents, helperIdentifiers[name]...)
+	}
+	return idents
+}
+
+// addType registers the helper types referenced by a Go type expression.
+func (h goHelpers) addType(expr string) {
+	for _, match := range helperRegex.FindAllStringSubmatch(expr, -1) {
+		h.add(match[1])
+	}
+}
+
+// String renders the sources of the collected helpers and registers their
+// imports.
+func (h goHelpers) String(imports goImports) string {
+	var builder strings.Builder
+	for _, helper := range entityHelpers {
+		if _, ok := h[helper.name]; !ok {
+			continue
+		}
+		builder.WriteString(helper.source)
+		for _, path := range helper.imports {
+			imports.add(path)
+		}
+	}
+	return builder.String()
+}
+
+// genericNullType is the Null[T] wrapper used by NullableGeneric. It reuses
+// sql.Null[T] for scanning and encodes to JSON as the value or null.
+const genericNullType = `// Null represents a value that may be NULL.
+type Null[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewNull returns a valid Null holding v.
+func NewNull[T any](v T) Null[T] {
+	return Null[T]{V: v, Valid: true}
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *Null[T]) Scan(value any) error {
+	var null sql.Null[T]
+	if err := null.Scan(value); err != nil {
+		return err
+	}
+	*n = Null[T](null)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n Null[T]) Value() (driver.Value, error) {
+	return sql.Null[T](n).Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = Null[T]{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.V); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+`
+
+// dateType is the Go type of date-only columns (@db.Date).
+const dateType = `// Date is a calendar date without a time of day.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the date of t in its location.
+func NewDate(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// Time returns the date at midnight UTC.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// String returns the date in the YYYY-MM-DD format.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *Date) UnmarshalText(text []byte) error {
+	t, err := time.Parse(time.DateOnly, string(text))
+	if err != nil {
+		return err
+	}
+	*d = NewDate(t)
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *Date) Scan(value any) error {
+	switch v := value.(type) {
+	case time.Time:
+		*d = NewDate(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v[:min(len(v), len(time.DateOnly))]))
+	case []byte:
+		return d.UnmarshalText(v[:min(len(v), len(time.DateOnly))])
+	}
+	return fmt.Errorf("cannot scan %T into Date", value)
+}
+
+// Value implements the driver.Valuer interface.
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+`
+
+// timeOfDayType is the Go type of time-only columns (@db.Time).
+const timeOfDayType = `// TimeOfDay is a time of day without a date or time zone.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// NewTimeOfDay returns the time of day of t in its location.
+func NewTimeOfDay(t time.Time) TimeOfDay {
+	return TimeOfDay{
+		Hour:       t.Hour(),
+		Minute:     t.Minute(),
+		Second:     t.Second(),
+		Nanosecond: t.Nanosecond(),
+	}
+}
+
+// String returns the time of day in the HH:MM:SS[.fraction] format.
+func (t TimeOfDay) String() string {
+	return time.Date(0, 1, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC).
+		Format("15:04:05.999999999")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	parsed, err := time.Parse("15:04:05.999999999", string(text))
+	if err != nil {
+		return err
+	}
+	*t = NewTimeOfDay(parsed)
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (t *TimeOfDay) Scan(value any) error {
+	switch v := value.(type) {
+	case time.Time:
+		*t = NewTimeOfDay(v)
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	}
+	return fmt.Errorf("cannot scan %T into TimeOfDay", value)
+}
+
+// Value implements the driver.Valuer interface.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.String(), nil
+}
+
+`
+
+// inetType is the Go type of network address columns (@db.Inet and
+// @db.Cidr), which database/sql drivers scan as text.
+const inetType = `// Inet is an IP network, or a host address with the full prefix length of
+// its IP version.
+type Inet struct {
+	netip.Prefix
+}
+
+// ParseInet parses an IP network like 10.0.0.0/8, or a host address with an
+// optional prefix length, like 192.168.0.1 or 192.168.0.1/24.
+func ParseInet(s string) (Inet, error) {
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return Inet{}, err
+		}
+		return Inet{netip.PrefixFrom(addr, addr.BitLen())}, nil
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return Inet{}, err
+	}
+	return Inet{prefix}, nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (i Inet) MarshalText() ([]byte, error) {
+	if !i.IsValid() {
+		return []byte{}, nil
+	}
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (i *Inet) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*i = Inet{}
+		return nil
+	}
+	parsed, err := ParseInet(string(text))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Inet) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return i.UnmarshalText([]byte(v))
+	case []byte:
+		return i.UnmarshalText(v)
+	case netip.Prefix:
+		*i = Inet{v}
+		return nil
+	}
+	return fmt.Errorf("cannot scan %T into Inet", value)
+}
+
+// Value implements the driver.Valuer interface.
+func (i Inet) Value() (driver.Value, error) {
+	if !i.IsValid() {
+		return nil, errors.New("cannot store an invalid Inet")
+	}
+	return i.String(), nil
+}
+
+`
+
+// relationType describes the relations returned by the Relations methods.
+const relationType = `// RelationKind is the cardinality of a relation, from the side of the
+// model it is described for.
+type RelationKind string
+
+const (
+	RelationOneToOne   RelationKind = "1-1"
+	RelationOneToMany  RelationKind = "1-n"
+	RelationManyToOne  RelationKind = "n-1"
+	RelationManyToMany RelationKind = "m-n"
+)
+
+// Relation describes a relation of a model, resolved from the @relation
+// attributes of the schema.
+type Relation struct {
+	// Field is the name of the relation field in the Prisma schema.
+	Field string
+	Kind  RelationKind
+	// Model is the related model, and Table its database table.
+	Model string
+	Table string
+	// Columns of the table of the model match the References of the
+	// related table, in order, directly or through the JoinTable.
+	Columns    []string
+	References []string
+	// ForeignKey reports whether Columns is the foreign key of the relation,
+	// as opposed to the primary or unique key it references.
+	ForeignKey bool
+	// JoinTable is the implicit join table of many-to-many relations, e.g.
+	// _CategoryToPost, whose JoinColumns reference Columns and whose
+	// JoinReferences reference References.
+	JoinTable      string
+	JoinColumns    []string
+	JoinReferences []string
+	// OnDelete and OnUpdate are the referential actions of the foreign key,
+	// e.g. Cascade or SetNull.
+	OnDelete string
+	OnUpdate string
+}
+
+`
+
+// ptrFunc sets the defaults of optional fields in pointer mode.
+const ptrFunc = `// ptr returns a pointer to v.
+func ptr[T any](v T) *T {
+	return &v
+}
+
+`
+
+// scanIDFunc scans the ID types.
+const scanIDFunc = `// scanID scans src into the primary key dst, which cannot be NULL.
+func scanID[T any](dst *T, src any) error {
+	var v sql.Null[T]
+	if err := v.Scan(src); err != nil {
+		return err
+	}
+	if !v.Valid {
+		return errors.New("cannot scan NULL into an ID")
+	}
+	*dst = v.V
+	return nil
+}
+
+`
+
+// cuidFunc generates the ids of @default(cuid()) fields.
+const cuidFunc = `// cuidCounter and cuidFingerprint keep the ids generated by newCUID
+// unique across calls and hosts.
+var (
+	cuidCounter     atomic.Uint32
+	cuidFingerprint = func() string {
+		host, _ := os.Hostname()
+		sum := len(host) + 36
+		for _, r := range host {
+			sum += int(r)
+		}
+		return cuidBlock(uint64(os.Getpid()), 2) + cuidBlock(uint64(sum), 2)
+	}()
+)
+
+// newCUID returns a collision-resistant id in the cuid format, like the
+// cuid() default of the Prisma Client.
+func newCUID() string {
+	var random [8]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		panic(err)
+	}
+	return "c" +
+		cuidBlock(uint64(time.Now().UnixMilli()), 8) +
+		cuidBlock(uint64(cuidCounter.Add(1)), 4) +
+		cuidFingerprint +
+		cuidBlock(uint64(binary.BigEndian.Uint32(random[:4])), 4) +
+		cuidBlock(uint64(binary.BigEndian.Uint32(random[4:])), 4)
+}
+
+// cuidBlock returns the last size base36 digits of n, zero padded.
+func cuidBlock(n uint64, size int) string {
+	digits := strconv.FormatUint(n, 36)
+	if len(digits) < size {
+		return strings.Repeat("0", size-len(digits)) + digits
+	}
+	return digits[len(digits)-size:]
+}
+
+`
+
+// cuid2Func generates the ids of @default(cuid(2)) fields.
+const cuid2Func = `// cuid2Counter keeps the ids generated by newCUID2 unique across calls.
+var cuid2Counter atomic.Uint32
+
+// newCUID2 returns a collision-resistant id in the cuid2 format, like the
+// cuid(2) default of the Prisma Client: a lowercase letter followed by 23
+// base36 digits of a hash of the time, a counter and random bytes.
+func newCUID2() string {
+	var random [32]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		panic(err)
+	}
+	hash := sha512.New()
+	fmt.Fprintf(hash, "%d:%d:", time.Now().UnixNano(), cuid2Counter.Add(1))
+	hash.Write(random[1:])
+	digits := new(big.Int).SetBytes(hash.Sum(nil)).Text(36)
+	return string(rune('a'+random[0]%26)) + digits[1:24]
+}
+
+`
+
+// nanoidFunc generates the ids of @default(nanoid()) fields.
+const nanoidFunc = `// nanoidAlphabet is the URL-safe alphabet of the ids generated by
+// newNanoID.
+const nanoidAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+
+// newNanoID returns a random URL-safe id of size characters, like the
+// nanoid() default of the Prisma Client.
+func newNanoID(size int) string {
+	random := make([]byte, size)
+	if _, err := rand.Read(random); err != nil {
+		panic(err)
+	}
+	id := make([]byte, size)
+	for i, b := range random {
+		id[i] = nanoidAlphabet[b&63]
+	}
+	return string(id)
+}
+
+`
+
+// ulidFunc generates the ids of @default(ulid()) fields.
+const ulidFunc = `// ulidAlphabet is the Crockford base32 alphabet of the ids generated by
+// newULID.
+const ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// newULID returns a lexicographically sortable id in the ULID format, like
+// the ulid() default of the Prisma Client: 48 bits of milliseconds since the
+// Unix epoch followed by 80 random bits.
+func newULID() string {
+	var data [16]byte
+	binary.BigEndian.PutUint64(data[:8], uint64(time.Now().UnixMilli())<<16)
+	if _, err := rand.Read(data[6:]); err != nil {
+		panic(err)
+	}
+
+	hi := binary.BigEndian.Uint64(data[:8])
+	lo := binary.BigEndian.Uint64(data[8:])
+	var id [26]byte
+	for i := len(id) - 1; i >= 0; i-- {
+		id[i] = ulidAlphabet[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(id[:])
+}
+
+`
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// idTypes maps the primary key fields of the models, and the relation scalar
+// fields referencing them, to the model whose ID type they hold.
+type idTypes map[*schema.Field]*schema.Model
+
+// newIDTypes resolves the ID types of a schema. Models get an ID type when
+// their primary key is a single generated column; a primary key that is
+// also a foreign key, like the userId of a 1-1 profile, holds the ID type of
+// the model it references instead. Relation scalar fields hold the ID type
+// of the referenced model when they reference its primary key, unless they
+// have a configured type override.
+func newIDTypes(prismaSchema *schema.Schema, resolver typeResolver) idTypes {
+	primaryKeys := map[*schema.Model]*schema.Field{}
+	owners := map[*schema.Field]*schema.Model{}
+	for _, model := range prismaSchema.Models {
+		key := model.PrimaryKey()
+		if key == nil || len(key.Fields) != 1 {
+			continue
+		}
+		field := key.Fields[0]
+		fieldType, ok := resolver.entityType(field)
+		if !ok || field.Type.List || !resolver.isColumn(field) ||
+			fieldType.base == "any" || strings.HasPrefix(fieldType.base, "*") {
+			continue
+		}
+		primaryKeys[model] = field
+		owners[field] = model
+	}
+
+	references := map[*schema.Field]*schema.Model{}
+	for _, model := range prismaSchema.Models {
+		for _, field := range model.Fields {
+			relation := field.Relation()
+			if relation == nil || len(relation.Fields) != 1 || len(relation.References) != 1 {
+				continue
+			}
+			target := prismaSchema.Model(field.Type.Name)
+			foreignKey := model.Field(relation.Fields[0])
+			if target == nil || foreignKey == nil || goOmitted(foreignKey) ||
+				primaryKeys[target] == nil || primaryKeys[target].Name != relation.References[0] {
+				continue
+			}
+			if _, ok := resolver.overrides.fields[foreignKey]; ok {
+				continue
+			}
+			references[foreignKey] = target
+		}
+	}
+
+	// resolve follows the foreign keys up to the model owning the ID type,
+	// falling back to the model of the primary key on cycles.
+	var resolve func(field *schema.Field, seen map[*schema.Field]bool) *schema.Model
+	resolve = func(field *schema.Field, seen map[*schema.Field]bool) *schema.Model {
+		target, ok := references[field]
+		if !ok || seen[field] {
+			return owners[field]
+		}
+		seen[field] = true
+		if owner := resolve(primaryKeys[target], seen); owner != nil {
+			return owner
+		}
+		return owners[field]
+	}
+
+	ids := idTypes{}
+	for field := range owners {
+		ids[field] = resolve(field, map[*schema.Field]bool{})
+	}
+	for field := range references {
+		ids[field] = resolve(field, map[*schema.Field]bool{})
+	}
+	return ids
+}
+
+// owns reports whether the ID type of model is generated, i.e. its primary
+// key holds its own ID type.
+func (ids idTypes) owns(model *schema.Model) bool {
+	key := model.PrimaryKey()
+	return key != nil && len(key.Fields) == 1 && ids[key.Fields[0]] == model
+}
+
+// parseIDType returns the ID type of a model, defined over the Go type of
+// its primary key, with the methods scanning, storing and encoding it as
+// the primary key type.
+func parseIDType(
+	model *schema.Model,
+	resolver typeResolver,
+	imports goImports,
+	helpers goHelpers,
+) string {
+	modelName := resolver.names.model(model)
+	underlying, _ := resolver.withIDTypes(nil).goType(model.PrimaryKey().Fields[0])
+	imports.addType(underlying.base, resolver.packages)
+	helpers.addType(underlying.base)
+	helpers.add("scanID")
+	imports.add("database/sql/driver")
+	imports.add("encoding/json")
+
+	doc := wrapComment(fmt.Sprintf(
+		"%s is the type of the primary key of %s and of the fields referencing it, so the ID of another model cannot be passed in its place.",
+		resolver.names.idType(model),
+		modelName,
+	))
+	return doc + fmt.Sprintf(`type %[1]s %[3]s
+
+// Scan implements the sql.Scanner interface.
+func (id *%[1]s) Scan(src any) error {
+	return scanID((*%[3]s)(id), src)
+}
+
+// Value implements the driver.Valuer interface.
+func (id %[1]s) Value() (driver.Value, error) {
+	return driver.DefaultParameterConverter.ConvertValue(%[3]s(id))
+}
+
+// MarshalJSON encodes the ID like its underlying %[3]s.
+func (id %[1]s) MarshalJSON() ([]byte, error) {
+	return json.Marshal(%[3]s(id))
+}
+
+// UnmarshalJSON decodes the ID like its underlying %[3]s.
+func (id *%[1]s) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*%[3]s)(id))
+}`,
+		resolver.names.idType(model),
+		modelName,
+		underlying.base,
+	)
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// keyMethods lists the methods generated on the models and views describing
+// their keys.
+var keyMethods = []string{"PrimaryKey", "PrimaryKeyValues", "UniqueKeys", "Indexes"}
+
+// parseKeys returns the methods describing the primary key, the unique
+// constraints and the indexes of a model or view. PrimaryKeyValues is left
+// out when a primary key field is not generated, e.g. with @go.omit.
+func parseKeys(
+	model *schema.Model,
+	relation string,
+	resolver typeResolver,
+) string {
+	modelName := resolver.names.model(model)
+
+	var primaryKey []string
+	var values []string
+	if key := model.PrimaryKey(); key != nil {
+		primaryKey = key.Columns()
+		for _, field := range key.Fields {
+			if _, ok := resolver.entityType(field); !ok {
+				values = nil
+				break
+			}
+			values = append(values, "m."+resolver.names.field(field))
+		}
+	}
+
+	var methods strings.Builder
+	methods.WriteString(fmt.Sprintf(
+		"// PrimaryKey returns the columns of the primary key of the %[2]s %[3]q.\n"+
+			"func (%[1]s) PrimaryKey() []string {\n\treturn %[4]s\n}\n\n",
+		modelName,
+		relation,
+		model.DBName(),
+		goStrings("[]string", primaryKey),
+	))
+	if len(values) > 0 {
+		methods.WriteString(fmt.Sprintf(
+			"// PrimaryKeyValues returns the values of the primary key columns of m,\n"+
+				"// in the order of PrimaryKey.\n"+
+				"func (m %[1]s) PrimaryKeyValues() []any {\n\treturn []any{%[2]s}\n}\n\n",
+			modelName,
+			strings.Join(values, ", "),
+		))
+	}
+	methods.WriteString(fmt.Sprintf(
+		"// UniqueKeys returns the columns of every unique constraint of the %[2]s\n"+
+			"// %[3]q, besides the primary key.\n"+
+			"func (%[1]s) UniqueKeys() [][]string {\n\treturn %[4]s\n}\n\n",
+		modelName,
+		relation,
+		model.DBName(),
+		goKeyColumns(model.UniqueKeys()),
+	))
+	methods.WriteString(fmt.Sprintf(
+		"// Indexes returns the columns of every index of the %[2]s %[3]q.\n"+
+			"func (%[1]s) Indexes() [][]string {\n\treturn %[4]s\n}",
+		modelName,
+		relation,
+		model.DBName(),
+		goKeyColumns(model.Indexes()),
+	))
+	return methods.String()
+}
+
+// goStrings returns the Go literal of a list of strings, or nil when empty.
+// The type is omitted when typ is empty, for elements of composite
+// literals.
+func goStrings(typ string, values []string) string {
+	if len(values) == 0 {
+		return "nil"
+	}
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	return typ + "{" + strings.Join(quoted, ", ") + "}"
+}
+
+// goKeyColumns returns the Go literal of the columns of keys, or nil when
+// there are none.
+func goKeyColumns(keys []*schema.Key) string {
+	if len(keys) == 0 {
+		return "nil"
+	}
+	columns := make([]string, 0, len(keys))
+	for _, key := range keys {
+		columns = append(columns, goStrings("", key.Columns()))
+	}
+	return "[][]string{" + strings.Join(columns, ", ") + "}"
+}
@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// relationIdentifiers are declared by the relationType helper.
+var relationIdentifiers = []string{
+	"Relation", "RelationKind", "RelationOneToOne", "RelationOneToMany",
+	"RelationManyToOne", "RelationManyToMany",
+}
+
+// relationInfo describes a relation from the side of one of its fields.
+type relationInfo struct {
+	field  *schema.Field
+	target *schema.Model
+	// kind is the Go constant of the relation kind, e.g. RelationOneToMany.
+	kind string
+	// columns of the table of the model are matched in order with the
+	// references of the table of the target, directly or through the
+	// joinColumns and joinReferences of the joinTable.
+	columns, references []string
+	// foreignKey reports whether columns is the foreign key of the relation.
+	foreignKey                  bool
+	joinTable                   string
+	joinColumns, joinReferences []string
+	onDelete, onUpdate          string
+}
+
+// modelRelations returns the relations of the relation fields of a model,
+// leaving out the fields marked with @go.omit and the invalid relations,
+// which are reported by schema.Validate.
+func modelRelations(prismaSchema *schema.Schema, model *schema.Model) []relationInfo {
+	var relations []relationInfo
+	for _, field := range model.Fields {
+		target := prismaSchema.Model(field.Type.Name)
+		if target == nil || goOmitted(field) {
+			continue
+		}
+		opposites := prismaSchema.OppositeRelationFields(model, field)
+		if len(opposites) != 1 {
+			continue
+		}
+		if relation, ok := newRelationInfo(prismaSchema, model, field, opposites[0]); ok {
+			relations = append(relations, relation)
+		}
+	}
+	return relations
+}
+
+func newRelationInfo(
+	prismaSchema *schema.Schema,
+	model *schema.Model,
+	field, opposite *schema.Field,
+) (relationInfo, bool) {
+	target := prismaSchema.Model(field.Type.Name)
+	info := relationInfo{field: field, target: target}
+
+	own, other := field.Relation(), opposite.Relation()
+	switch {
+	case field.Type.List && opposite.Type.List && own != nil && len(own.Fields) > 0:
+		// MongoDB stores many-to-many relations in list fields on both sides.
+		info.kind = "RelationManyToMany"
+		info.foreignKey = true
+		info.columns = fieldColumns(model, own.Fields)
+		info.references = fieldColumns(target, own.References)
+		info.onDelete, info.onUpdate = referentialActions(model, own)
+
+	case field.Type.List && opposite.Type.List && (other == nil || len(other.Fields) == 0):
+		// Implicit many-to-many relations are stored in a join table named
+		// after the relation, or the models in alphabetical order, whose A
+		// column references the first model and B the second one.
+		modelKey, targetKey := model.PrimaryKey(), target.PrimaryKey()
+		if modelKey == nil || targetKey == nil {
+			return relationInfo{}, false
+		}
+		names := []string{model.Name, target.Name}
+		slices.Sort(names)
+		info.joinTable = "_" + strings.Join(names, "To")
+		if own != nil && own.Name != "" {
+			info.joinTable = "_" + own.Name
+		}
+		first := model.Name < target.Name ||
+			(model.Name == target.Name && field.Name < opposite.Name)
+		info.joinColumns, info.joinReferences = []string{"A"}, []string{"B"}
+		if !first {
+			info.joinColumns, info.joinReferences = info.joinReferences, info.joinColumns
+		}
+		info.kind = "RelationManyToMany"
+		info.columns, info.references = modelKey.Columns(), targetKey.Columns()
+		info.onDelete, info.onUpdate = "Cascade", "Cascade"
+
+	case own != nil && len(own.Fields) > 0:
+		info.kind = "RelationManyToOne"
+		if !opposite.Type.List {
+			info.kind = "RelationOneToOne"
+		}
+		info.foreignKey = true
+		info.columns = fieldColumns(model, own.Fields)
+		info.references = fieldColumns(target, own.References)
+		info.onDelete, info.onUpdate = referentialActions(model, own)
+
+	case other != nil && len(other.Fields) > 0:
+		switch {
+		case field.Type.List && opposite.Type.List:
+			info.kind = "RelationManyToMany"
+		case field.Type.List:
+			info.kind = "RelationOneToMany"
+		default:
+			info.kind = "RelationOneToOne"
+		}
+		info.columns = fieldColumns(model, other.References)
+		info.references = fieldColumns(target, other.Fields)
+		info.onDelete, info.onUpdate = referentialActions(target, other)
+
+	default:
+		return relationInfo{}, false
+	}
+	return info, true
+}
+
+// fieldColumns returns the database columns of the named fields of model.
+func fieldColumns(model *schema.Model, fields []string) []string {
+	columns := make([]string, 0, len(fields))
+	for _, name := range fields {
+		if field := model.Field(name); field != nil {
+			columns = append(columns, field.DBName())
+		}
+	}
+	return columns
+}
+
+// referentialActions returns the onDelete and onUpdate actions of a
+// relation, falling back to the defaults of Prisma: SetNull when a foreign
+// key field is optional, Restrict otherwise, and Cascade on update.
+func referentialActions(model *schema.Model, relation *schema.Relation) (string, string) {
+	onDelete := "Restrict"
+	for _, name := range relation.Fields {
+		if field := model.Field(name); field != nil && field.Type.Optional {
+			onDelete = "SetNull"
+		}
+	}
+	return cmp.Or(relation.OnDelete, onDelete), cmp.Or(relation.OnUpdate, "Cascade")
+}
+
+// parseRelations returns the Relations method of a model, describing its
+// relations.
+func parseRelations(
+	model *schema.Model,
+	relations []relationInfo,
+	resolver typeResolver,
+	helpers goHelpers,
+) string {
+	helpers.add("Relation")
+
+	entries := make([]string, 0, len(relations))
+	for _, relation := range relations {
+		var entry strings.Builder
+		entry.WriteString(fmt.Sprintf("\t\t{\n\t\t\tField: %q,\n", relation.field.Name))
+		entry.WriteString(fmt.Sprintf("\t\t\tKind: %s,\n", relation.kind))
+		entry.WriteString(fmt.Sprintf("\t\t\tModel: %q,\n", relation.target.Name))
+		entry.WriteString(fmt.Sprintf("\t\t\tTable: %q,\n", relation.target.DBName()))
+		entry.WriteString(fmt.Sprintf("\t\t\tColumns: %s,\n", goStrings("[]string", relation.columns)))
+		entry.WriteString(fmt.Sprintf("\t\t\tReferences: %s,\n", goStrings("[]string", relation.references)))
+		if relation.foreignKey {
+			entry.WriteString("\t\t\tForeignKey: true,\n")
+		}
+		if relation.joinTable != "" {
+			entry.WriteString(fmt.Sprintf("\t\t\tJoinTable: %q,\n", relation.joinTable))
+			entry.WriteString(fmt.Sprintf("\t\t\tJoinColumns: %s,\n", goStrings("[]string", relation.joinColumns)))
+			entry.WriteString(fmt.Sprintf("\t\t\tJoinReferences: %s,\n", goStrings("[]string", relation.joinReferences)))
+		}
+		entry.WriteString(fmt.Sprintf("\t\t\tOnDelete: %q,\n", relation.onDelete))
+		entry.WriteString(fmt.Sprintf("\t\t\tOnUpdate: %q,\n\t\t},\n", relation.onUpdate))
+		entries = append(entries, entry.String())
+	}
+
+	body := "nil"
+	if len(entries) > 0 {
+		body = "[]Relation{\n" + strings.Join(entries, "") + "\t}"
+	}
+	return fmt.Sprintf(
+		"// Relations returns the relations of %[1]s, in the order of its relation\n"+
+			"// fields.\n"+
+			"func (%[1]s) Relations() []Relation {\n\treturn %[2]s\n}",
+		resolver.names.model(model),
+		body,
+	)
+}
+
+// relationFieldType returns the Go type of a relation field generated in the
+// struct of its model, a slice for lists and a pointer otherwise, as the
+// related structs may reference each other. Relations to models generated
+// in another package are left out, as both packages would import each
+// other.
+func relationFieldType(field *schema.Field, resolver typeResolver) (string, bool) {
+	target := resolver.schema.Model(field.Type.Name)
+	if target == nil || goOmitted(field) {
+		return "", false
+	}
+	if _, ok := resolver.modelPackages[target.Name]; ok {
+		return "", false
+	}
+	if field.Type.List {
+		return "[]" + resolver.names.model(target), true
+	}
+	return "*" + resolver.names.model(target), true
+}
+
+// parseRelationField returns the struct field of a relation field, tagged
+// so the database libraries skip it.
+func parseRelationField(
+	model *schema.Model,
+	field *schema.Field,
+	goType string,
+	resolver typeResolver,
+	tagTemplate *template.Template,
+	opts EntitiesOptions,
+) (string, error) {
+	fieldName := resolver.names.field(field)
+	tf := newTagField(model, field, fieldName, goType, opts.JSONTag, resolver.schema)
+	tf.Column, tf.Relation = "", true
+	tags, err := structTags(tf, opts.Tags, tagTemplate)
+	if err != nil {
+		return "", err
+	}
+	if tagTemplate != nil {
+		// sqlx and scany map every field without a db:"-" tag to a column
+		tags = mergeTags(tags, []string{`db:"-"`})
+	}
+	tags = mergeTags(tags, field.Tags())
+	return goDocComment(fieldName, field.Doc, "\t") + fmt.Sprintf("\t%s %s `%s`", fieldName, goType, tags), nil
+}
+
+// aggregateName returns the name of the aggregate struct of a relation
+// field, e.g. UserWithPosts.
+func aggregateName(names goNames, model *schema.Model, field *schema.Field) string {
+	return names.model(model) + "With" + names.field(field)
+}
+
+// parseAggregates returns the aggregate structs of the relation fields of a
+// model, embedding the model and holding the related records.
+func parseAggregates(
+	model *schema.Model,
+	resolver typeResolver,
+	tagTemplate *template.Template,
+	opts EntitiesOptions,
+) (string, error) {
+	modelName := resolver.names.model(model)
+
+	var aggregates []string
+	for _, field := range model.Fields {
+		if resolver.kind(field) != relationField {
+			continue
+		}
+		goType, ok := relationFieldType(field, resolver)
+		if !ok {
+			continue
+		}
+		structField, err := parseRelationField(model, field, goType, resolver, tagTemplate, opts)
+		if err != nil {
+			return "", err
+		}
+		aggregates = append(aggregates, fmt.Sprintf(
+			"// %[1]s is a %[2]s loaded with its %[3]s relation.\n"+
+				"type %[1]s struct {\n\t%[2]s\n%[4]s\n}",
+			aggregateName(resolver.names, model, field),
+			modelName,
+			field.Name,
+			structField,
+		))
+	}
+	return strings.Join(aggregates, "\n\n"), nil
+}
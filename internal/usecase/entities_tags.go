@@ -0,0 +1,420 @@
+package usecase
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+	"github.com/ettle/strcase"
+)
+
+// TagProfile selects a family of struct tags generated on the entities.
+type TagProfile string
+
+const (
+	// TagSQLX emits `db:"column"`, used by sqlx and scany.
+	TagSQLX TagProfile = "sqlx"
+	// TagPgx emits `db:"column"`, used by pgx.RowToStructByName.
+	TagPgx TagProfile = "pgx"
+	// TagJSON emits `json:"name,omitempty"`, named according to the
+	// JSONTagSource.
+	TagJSON TagProfile = "json"
+	// TagBun emits bun tags, e.g. `bun:"id,pk,autoincrement"`.
+	TagBun TagProfile = "bun"
+	// TagGorm emits gorm tags, e.g. `gorm:"column:id;primaryKey"`.
+	TagGorm TagProfile = "gorm"
+	// TagBSON emits `bson:"column"`, used by the MongoDB driver.
+	TagBSON TagProfile = "bson"
+	// TagValidate emits go-playground/validator tags, e.g.
+	// `validate:"required"`.
+	TagValidate TagProfile = "validate"
+)
+
+// tagProfiles lists the supported profiles, in the order their tags are
+// emitted.
+var tagProfiles = []TagProfile{
+	TagSQLX,
+	TagPgx,
+	TagJSON,
+	TagBun,
+	TagGorm,
+	TagBSON,
+	TagValidate,
+}
+
+// defaultTagProfiles returns the profiles used when none are configured.
+func defaultTagProfiles(provider string) []TagProfile {
+	if provider == "mongodb" {
+		return []TagProfile{TagSQLX, TagJSON, TagBSON}
+	}
+	return []TagProfile{TagSQLX, TagJSON}
+}
+
+// tagField describes a field of a generated struct. The struct tags are
+// rendered from it, and it is the data of custom tag templates.
+type tagField struct {
+	// Model and Field are the Prisma names, Table and Column the database
+	// names.
+	Model, Field  string
+	Table, Column string
+	// GoName and GoType are the name and type of the struct field.
+	GoName, GoType string
+	// JSON is the name of the field in json tags.
+	JSON string
+	// Default is the SQL expression of the @default value set by the
+	// database, empty when the database sets none.
+	Default string
+
+	PrimaryKey    bool
+	Unique        bool
+	Optional      bool
+	List          bool
+	AutoIncrement bool
+	// Generated reports whether the value may be left unset on insert:
+	// the field has a @default, is auto-incremented or is @updatedAt.
+	Generated bool
+	// Enum holds the database values of enum fields.
+	Enum []string
+	// Relation reports whether the field is a relation field, which has no
+	// column.
+	Relation bool
+
+	// auto reports whether the field defaults to @default(auto()).
+	auto bool
+}
+
+// newTagField collects the tag data of a field of model.
+func newTagField(
+	model *schema.Model,
+	field *schema.Field,
+	goName, goType string,
+	jsonTag JSONTagSource,
+	prismaSchema *schema.Schema,
+) tagField {
+	tf := tagField{
+		Model:      model.Name,
+		Field:      field.Name,
+		Table:      model.DBName(),
+		Column:     field.DBName(),
+		GoName:     goName,
+		GoType:     goType,
+		PrimaryKey: isPrimaryKey(model, field),
+		Unique:     field.HasAttribute("unique"),
+		Optional:   field.Type.Optional,
+		List:       field.Type.List,
+		Generated:  field.HasAttribute("default") || field.HasAttribute("updatedAt"),
+		auto:       isAutoDefault(field),
+	}
+
+	switch jsonTag {
+	case JSONTagColumn:
+		tf.JSON = tf.Column
+	case JSONTagCamel:
+		tf.JSON = strcase.ToCamel(field.Name)
+	case JSONTagSnake:
+		tf.JSON = strcase.ToSnake(field.Name)
+	default:
+		tf.JSON = field.Name
+	}
+
+	enum := prismaSchema.Enum(field.Type.Name)
+	if enum != nil {
+		for _, value := range enum.Values {
+			tf.Enum = append(tf.Enum, value.DBName())
+		}
+	}
+
+	tf.Default, tf.AutoIncrement = sqlDefault(field, enum)
+	// Struct tags are raw string literals, which cannot hold backquotes.
+	if strings.Contains(tf.Default, "`") {
+		tf.Default = ""
+	}
+	return tf
+}
+
+// isPrimaryKey reports whether the field is the @id of the model or part of
+// its @@id.
+func isPrimaryKey(model *schema.Model, field *schema.Field) bool {
+	key := model.PrimaryKey()
+	return key != nil && key.Has(field)
+}
+
+// sqlDefault returns the SQL expression of the @default value of a field,
+// when it is set by the database, and whether it is autoincrement(). Values
+// generated by the Prisma Client, like uuid() or cuid(), have no SQL
+// expression.
+func sqlDefault(field *schema.Field, enum *schema.Enum) (string, bool) {
+	attr := field.Attribute("default")
+	if attr == nil {
+		return "", false
+	}
+	arg := attr.Arg(0, "value")
+	if arg == nil {
+		return "", false
+	}
+
+	switch value := arg.Value.(type) {
+	case *schema.StringLit:
+		return "'" + strings.ReplaceAll(value.Value, "'", "''") + "'", false
+	case *schema.NumberLit:
+		return value.Value, false
+	case *schema.Ident:
+		if enum != nil {
+			for _, enumValue := range enum.Values {
+				if enumValue.Name == value.Name {
+					return "'" + enumValue.DBName() + "'", false
+				}
+			}
+		}
+		return value.Name, false
+	case *schema.FuncCall:
+		switch value.Name {
+		case "now":
+			return "CURRENT_TIMESTAMP", false
+		case "autoincrement":
+			return "", true
+		case "dbgenerated":
+			if expr := value.Arg(0, ""); expr != nil {
+				sql, _ := schema.StringValue(expr.Value)
+				return sql, false
+			}
+		}
+	}
+	return "", false
+}
+
+// tags renders the struct tag of a profile.
+func (f tagField) tags(profile TagProfile) string {
+	if f.Relation {
+		return f.relationTags(profile)
+	}
+
+	switch profile {
+	case TagSQLX, TagPgx:
+		return "db:" + strconv.Quote(f.Column)
+
+	case TagJSON:
+		return "json:" + strconv.Quote(f.JSON+",omitempty")
+
+	case TagBSON:
+		// Let MongoDB generate the ids of @default(auto()) fields.
+		options := ""
+		if f.Optional || f.auto {
+			options = ",omitempty"
+		}
+		return "bson:" + strconv.Quote(f.Column+options)
+
+	case TagBun:
+		options := []string{f.Column}
+		if f.PrimaryKey {
+			options = append(options, "pk")
+		}
+		if f.AutoIncrement {
+			options = append(options, "autoincrement")
+		}
+		if f.Optional {
+			options = append(options, "nullzero")
+		} else if !f.PrimaryKey {
+			options = append(options, "notnull")
+		}
+		if f.Unique {
+			options = append(options, "unique")
+		}
+		if f.Default != "" {
+			options = append(options, "default:"+f.Default)
+		}
+		if f.List {
+			options = append(options, "array")
+		}
+		return "bun:" + strconv.Quote(strings.Join(options, ","))
+
+	case TagGorm:
+		options := []string{"column:" + f.Column}
+		if f.PrimaryKey {
+			options = append(options, "primaryKey")
+		}
+		if f.AutoIncrement {
+			options = append(options, "autoIncrement")
+		}
+		if !f.Optional {
+			options = append(options, "not null")
+		}
+		if f.Unique {
+			options = append(options, "unique")
+		}
+		if f.Default != "" {
+			options = append(options, "default:"+f.Default)
+		}
+		return "gorm:" + strconv.Quote(strings.Join(options, ";"))
+
+	case TagValidate:
+		var rules []string
+		switch {
+		case f.Optional:
+			rules = append(rules, "omitempty")
+		case !f.Generated && !f.List && f.requiresValue():
+			rules = append(rules, "required")
+		}
+		if rule, ok := oneofRule(f.Enum); ok {
+			if f.List {
+				rules = append(rules, "dive", rule)
+			} else {
+				rules = append(rules, rule)
+			}
+		}
+		if len(rules) == 0 {
+			return ""
+		}
+		return "validate:" + strconv.Quote(strings.Join(rules, ","))
+	}
+
+	return ""
+}
+
+// oneofRule returns the oneof rule of validator allowing the enum values,
+// and false when there are none or one of them cannot be expressed.
+func oneofRule(values []string) (string, bool) {
+	if len(values) == 0 {
+		return "", false
+	}
+	params := make([]string, 0, len(values))
+	for _, value := range values {
+		param, ok := oneofParam(value)
+		if !ok {
+			return "", false
+		}
+		params = append(params, param)
+	}
+	return "oneof=" + strings.Join(params, " "), true
+}
+
+// oneofParam returns an enum value as a parameter of the oneof rule.
+// validator splits the parameters on spaces, except inside single quotes,
+// and the rules on commas and pipes, which are written 0x2C and 0x7C. It
+// strips every single quote, so values holding one cannot be expressed, nor
+// values holding 0x2C or 0x7C, or a backquote, which the raw string literal
+// of the struct tag cannot hold.
+func oneofParam(value string) (string, bool) {
+	if strings.ContainsAny(value, "'`") ||
+		strings.Contains(value, "0x2C") ||
+		strings.Contains(value, "0x7C") {
+		return "", false
+	}
+	param := strings.NewReplacer(",", "0x2C", "|", "0x7C").Replace(value)
+	if param == "" || strings.ContainsFunc(param, unicode.IsSpace) {
+		param = "'" + param + "'"
+	}
+	return param, true
+}
+
+// checkOneofValues warns about the enum values the oneof rule of validator
+// cannot express, leaving the rule out of the validate tags of the fields of
+// their enum.
+func checkOneofValues(prismaSchema *schema.Schema, opts EntitiesOptions) schema.Diagnostics {
+	var diags schema.Diagnostics
+	if !slices.Contains(opts.Tags, TagValidate) {
+		return diags
+	}
+	for _, enum := range prismaSchema.Enums {
+		for _, value := range enum.Values {
+			if _, ok := oneofParam(value.DBName()); !ok {
+				diags.Warningf(
+					value.Pos,
+					"value %q of enum %s cannot be expressed in the oneof rule of validator, so the validate tags of its fields have no oneof rule",
+					value.DBName(),
+					enum.Name,
+				)
+			}
+		}
+	}
+	return diags
+}
+
+// relationTags renders the struct tag of a profile for a relation field,
+// which the database libraries skip.
+func (f tagField) relationTags(profile TagProfile) string {
+	switch profile {
+	case TagSQLX, TagPgx:
+		return `db:"-"`
+	case TagJSON:
+		return "json:" + strconv.Quote(f.JSON+",omitempty")
+	case TagBun, TagGorm, TagBSON:
+		return string(profile) + `:"-"`
+	}
+	return ""
+}
+
+// requiresValue reports whether the zero value of the Go type is not a
+// meaningful value, so validate:"required" can be used. Zero numbers and
+// false are valid values.
+func (f tagField) requiresValue() bool {
+	switch f.GoType {
+	case "bool", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64":
+		return false
+	}
+	return true
+}
+
+// structTags renders the struct tags of a field with the profiles, or with
+// tmpl when set.
+func structTags(
+	field tagField,
+	profiles []TagProfile,
+	tmpl *template.Template,
+) (string, error) {
+	if tmpl != nil {
+		var tags strings.Builder
+		if err := tmpl.Execute(&tags, field); err != nil {
+			return "", fmt.Errorf(
+				"error rendering tag template for field %s.%s: %w",
+				field.Model,
+				field.Field,
+				err,
+			)
+		}
+		return strings.TrimSpace(tags.String()), nil
+	}
+
+	tags := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		// sqlx and pgx share the db tag.
+		if tag := field.tags(profile); tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return strings.Join(tags, " "), nil
+}
+
+// parseTagTemplate parses a custom tag template, returning nil when text is
+// empty. The template is executed once on an empty field, so references to
+// unknown fields are reported before generating anything.
+func parseTagTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	tmpl, err := template.New("tag template").Parse(text)
+	if err == nil {
+		err = tmpl.Execute(io.Discard, tagField{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// tagProfileNames returns the names of the supported profiles.
+func tagProfileNames() []string {
+	names := make([]string, 0, len(tagProfiles))
+	for _, profile := range tagProfiles {
+		names = append(names, string(profile))
+	}
+	return names
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"cmp"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrismaToGoStructs(t *testing.T) {
+	tests := []struct {
+		name string
+		// out is the output directory, and golden directory, of the test
+		// case, entities by default. Variants of the options get their own.
+		out  string
+		opts EntitiesOptions
+	}{
+		{name: "schema_folder"},
+		{name: "nullable"},
+		{name: "nullable", out: "entities_sql", opts: EntitiesOptions{Nullable: NullableSQL}},
+		{name: "nullable", out: "entities_pgtype", opts: EntitiesOptions{Nullable: NullablePgtype}},
+		{name: "nullable", out: "entities_generic", opts: EntitiesOptions{Nullable: NullableGeneric}},
+		{name: "native_types_postgresql"},
+		{name: "decimal"},
+		{name: "decimal", out: "entities_shopspring", opts: EntitiesOptions{Decimal: DecimalShopspring}},
+		{name: "decimal", out: "entities_float64", opts: EntitiesOptions{Decimal: DecimalFloat64}},
+		{name: "decimal", out: "entities_shopspring_sql", opts: EntitiesOptions{Decimal: DecimalShopspring, Nullable: NullableSQL}},
+		{name: "native_types_mysql"},
+		{name: "native_types_sqlite"},
+		{name: "type_overrides"},
+		{name: "enums"},
+		{name: "enum_values"},
+		{name: "column_names"},
+		{name: "column_names_json_column"},
+		{name: "entities_defaults"},
+		{name: "validate_tags"},
+		{name: "doc_comments"},
+		{name: "mongodb"},
+		{name: "views"},
+		{name: "ignore"},
+		{name: "ignore", out: "entities_include_ignored", opts: EntitiesOptions{IncludeIgnored: true}},
+		{name: "multi_schema"},
+		{name: "multi_schema", out: "entities_per_schema", opts: EntitiesOptions{PackagePerSchema: true}},
+		{name: "annotations"},
+		{name: "tag_profiles"},
+		{name: "tag_profiles", out: "entities_json_snake", opts: EntitiesOptions{Tags: []TagProfile{TagJSON}, JSONTag: JSONTagSnake}},
+		{
+			name: "tag_profiles",
+			out:  "entities_tag_template",
+			opts: EntitiesOptions{
+				TagTemplate: `{{printf "db:%q" .Column}}{{if .PrimaryKey}} pk:"true"{{end}}{{if .Optional}} null:"true"{{end}}`,
+			},
+		},
+		{name: "naming"},
+		{name: "keys"},
+		{name: "defaults"},
+		{name: "defaults", out: "entities_sql", opts: EntitiesOptions{Nullable: NullableSQL}},
+		{name: "id_types"},
+		{name: "id_types", out: "entities_sql", opts: EntitiesOptions{Nullable: NullableSQL}},
+		{name: "relations", opts: EntitiesOptions{Relations: RelationsMetadata}},
+		{name: "relations", out: "entities_fields", opts: EntitiesOptions{Relations: RelationsFields}},
+		{name: "relations", out: "entities_aggregates", opts: EntitiesOptions{Relations: RelationsAggregates}},
+	}
+
+	for _, tt := range tests {
+		out := cmp.Or(tt.out, "entities")
+		t.Run(tt.name+"/"+out, func(t *testing.T) {
+			testGolden(t, tt.name, out, func(schemaPath, configPath, outDir string) ([]string, error) {
+				opts := tt.opts
+				opts.ConfigPath = configPath
+				return PrismaToGoStructs(schemaPath, outDir, opts)
+			})
+		})
+	}
+}
+
+const mongoDBSchema = `datasource db {
+  provider = "mongodb"
+  url      = env("DATABASE_URL")
+}
+
+model User {
+  id    String  @id @default(auto()) @map("_id") @db.ObjectId
+  email String  @unique
+  name  String?
+}
+`
+
+// TestMongoDBOptions checks that both commands reject the entities options
+// MongoDB does not support with the same error.
+func TestMongoDBOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   string
+	}{
+		{
+			name:   "id types",
+			config: "entities:\n  id_types: true\n",
+			want:   "ID types are not supported for MongoDB, the BSON codecs do not apply to types defined over primitive.ObjectID",
+		},
+		{
+			name:   "sql nullable mode",
+			config: "entities:\n  nullable: sql\n",
+			want:   `nullable mode "sql" is not supported for MongoDB, the BSON codecs only decode optional fields into pointers`,
+		},
+		{
+			name:   "generic nullable mode",
+			config: "entities:\n  nullable: generic\n",
+			want:   `nullable mode "generic" is not supported for MongoDB, the BSON codecs only decode optional fields into pointers`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string][]byte{
+				"schema.prisma":        []byte(mongoDBSchema),
+				"prisma-go-tools.yaml": []byte(tt.config),
+			})
+			schemaPath := filepath.Join(dir, "schema.prisma")
+			configPath := filepath.Join(dir, "prisma-go-tools.yaml")
+
+			_, err := PrismaToGoStructs(schemaPath, filepath.Join(dir, "entities"), EntitiesOptions{ConfigPath: configPath})
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("PrismaToGoStructs() error = %v, want %q", err, tt.want)
+			}
+			_, err = PrismaToSQLTables(schemaPath, filepath.Join(dir, "tables"), TablesOptions{ConfigPath: configPath})
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("PrismaToSQLTables() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// goldenModule is the go.mod of the module the generated code is written to
+// and built in. Its requirements cover every package the generated code may
+// import; their checksums are in testdata/go.sum.
+const goldenModule = `module example.com/app
+
+go 1.22
+
+require (
+	github.com/google/uuid v1.6.0
+	github.com/jackc/pgx/v5 v5.7.1
+	github.com/shopspring/decimal v1.4.0
+	go.mongodb.org/mongo-driver v1.17.6
+)
+`
+
+// generator runs a command on the schema and configuration of a test case,
+// writing to outDir, and returns the files it wrote.
+type generator func(schemaPath, configPath, outDir string) ([]string, error)
+
+// testCase is a test case directory in testdata. The prisma directory holds
+// the schema, either a schema.prisma file or a schema folder, and the
+// migrations; prisma-go-tools.yaml, when present, is the configuration. The
+// check directory holds, for each output directory, Go code using the
+// generated code, like tests of its behavior.
+type testCase struct {
+	// dir is the temporary module the prisma directory is copied to.
+	dir        string
+	schemaPath string
+	configPath string
+}
+
+// newTestCase copies the schema of the test case testdata/name to a
+// temporary module.
+func newTestCase(t *testing.T, name string) testCase {
+	t.Helper()
+
+	tc := testCase{dir: t.TempDir()}
+	src := filepath.Join("testdata", name)
+
+	files := map[string][]byte{"go.mod": []byte(goldenModule)}
+	sum, err := os.ReadFile(filepath.Join("testdata", "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files["go.sum"] = sum
+
+	err = filepath.WalkDir(filepath.Join(src, "prisma"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)], err = os.ReadFile(path)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, tc.dir, files)
+
+	tc.schemaPath = filepath.Join(tc.dir, "prisma", "schema.prisma")
+	if _, err := os.Stat(tc.schemaPath); err != nil {
+		tc.schemaPath = filepath.Join(tc.dir, "prisma", "schema")
+	}
+
+	config := filepath.Join(src, "prisma-go-tools.yaml")
+	if _, err := os.Stat(config); err == nil {
+		tc.configPath = config
+	}
+
+	return tc
+}
+
+// run runs gen with the output directory out of the module.
+func (tc testCase) run(gen generator, out string) ([]string, error) {
+	return gen(tc.schemaPath, tc.configPath, filepath.Join(tc.dir, out))
+}
+
+// testGolden runs gen on the test case testdata/name, compares the files it
+// writes to the output directory out with the golden files in
+// testdata/name/out and checks that the generated code builds.
+func testGolden(t *testing.T, name, out string, gen generator) {
+	t.Helper()
+
+	tc := newTestCase(t, name)
+	paths, err := tc.run(gen, out)
+	if err != nil {
+		t.Fatalf("error generating %s: %v", out, err)
+	}
+
+	checkGolden(t, filepath.Join("testdata", name, out), readFiles(t, filepath.Join(tc.dir, out), paths))
+	tc.copyChecks(t, name, out)
+	checkBuild(t, tc.dir)
+}
+
+// copyChecks copies the Go code using the output directory out of the test
+// case testdata/name, if any, to the module.
+func (tc testCase) copyChecks(t *testing.T, name, out string) {
+	t.Helper()
+
+	dir := filepath.Join("testdata", name, "check", out)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{}
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files["check/"+out+"/"+entry.Name()] = content
+	}
+	writeFiles(t, tc.dir, files)
+}
+
+// readFiles reads the files at paths, keyed by their slash-separated path
+// relative to dir.
+func readFiles(t *testing.T, dir string, paths []string) map[string][]byte {
+	t.Helper()
+
+	files := map[string][]byte{}
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[filepath.ToSlash(rel)] = content
+	}
+	return files
+}
+
+// writeFiles creates the files in dir, keyed by their slash-separated path
+// relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// checkGolden compares files with the golden files in dir, or replaces the
+// golden files with them when the -update flag is set.
+func checkGolden(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+
+	if *update {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+		writeFiles(t, dir, files)
+		return
+	}
+
+	golden := map[string][]byte{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		golden[filepath.ToSlash(rel)], err = os.ReadFile(path)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("error reading golden files, run the tests with -update to create them: %v", err)
+	}
+
+	names := map[string]bool{}
+	for name := range files {
+		names[name] = true
+	}
+	for name := range golden {
+		names[name] = true
+	}
+	for _, name := range slices.Sorted(maps.Keys(names)) {
+		want, inGolden := golden[name]
+		got, generated := files[name]
+		switch {
+		case !inGolden:
+			t.Errorf("%s: unexpected file %s", dir, name)
+		case !generated:
+			t.Errorf("%s: file %s was not generated", dir, name)
+		case !bytes.Equal(got, want):
+			t.Errorf("%s: %s differs from the golden file:\n%s", dir, name, firstDiff(got, want))
+		}
+	}
+}
+
+// firstDiff describes the first line that differs between got and want.
+func firstDiff(got, want []byte) string {
+	gotLines := strings.Split(string(got), "\n")
+	wantLines := strings.Split(string(want), "\n")
+	for i := range max(len(gotLines), len(wantLines)) {
+		var gotLine, wantLine string
+		if i < len(gotLines) {
+			gotLine = gotLines[i]
+		}
+		if i < len(wantLines) {
+			wantLine = wantLines[i]
+		}
+		if gotLine != wantLine {
+			return fmt.Sprintf("line %d:\n  got:  %s\n  want: %s", i+1, gotLine, wantLine)
+		}
+	}
+	return ""
+}
+
+// checkBuild builds the generated code in the module at dir and runs the
+// tests of its check directory, which check the behavior of the generated
+// code. It needs the modules of goldenModule, so it is skipped
+// in short mode.
+func checkBuild(t *testing.T, dir string) {
+	t.Helper()
+
+	if testing.Short() {
+		return
+	}
+	for _, args := range [][]string{{"build", "./..."}, {"test", "./..."}} {
+		command := exec.Command("go", args...)
+		command.Dir = dir
+		if output, err := command.CombinedOutput(); err != nil {
+			t.Errorf("go %s of the generated code failed: %v\n%s", args[0], err, output)
+			return
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// listFiles returns the slash-separated paths of the files in dir.
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		files = append(files, filepath.ToSlash(rel))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(files)
+	return files
+}
+
+func TestZipMigrations(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		files  map[string][]byte
+		want   []string
+	}{
+		{
+			name:   "schema file",
+			schema: "prisma/schema.prisma",
+			files: map[string][]byte{
+				"prisma/schema.prisma":                      []byte("model User {\n  id Int @id\n}\n"),
+				"prisma/migrations/20240101000000_init.sql": []byte("CREATE TABLE"),
+				"prisma/migrations/migration_lock.toml":     []byte(`provider = "postgresql"`),
+			},
+			want: []string{
+				"20240101000000_init/migration.sql",
+				"migration_lock.toml",
+			},
+		},
+		{
+			name:   "schema folder with the datasource in a subfolder",
+			schema: "prisma/schema",
+			files: map[string][]byte{
+				"prisma/schema/config/db.prisma":                          []byte("datasource db {\n  provider = \"postgresql\"\n  url = env(\"DATABASE_URL\")\n}\n"),
+				"prisma/schema/config/migrations/20240101000000_init.sql": []byte("CREATE TABLE"),
+			},
+			want: []string{"20240101000000_init/migration.sql"},
+		},
+		{
+			name:   "schema that does not parse",
+			schema: "prisma/schema.prisma",
+			files: map[string][]byte{
+				"prisma/schema.prisma":                      []byte("model User {\n  id Int @id\n"),
+				"prisma/migrations/20240101000000_init.sql": []byte("CREATE TABLE"),
+			},
+			want: []string{"20240101000000_init/migration.sql"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tt.files)
+			schemaPath := filepath.Join(dir, tt.schema)
+
+			if err := ZipMigrations(schemaPath); err != nil {
+				t.Fatalf("ZipMigrations() error = %v", err)
+			}
+			migrationsDir := schemaMigrationsDir(schemaPath)
+			if got := listFiles(t, migrationsDir); !slices.Equal(got, tt.want) {
+				t.Errorf("zipped migrations = %q, want %q", got, tt.want)
+			}
+
+			if err := UnZipMigrations(schemaPath); err != nil {
+				t.Fatalf("UnZipMigrations() error = %v", err)
+			}
+			for path, content := range tt.files {
+				got, err := os.ReadFile(filepath.Join(dir, path))
+				if err != nil || string(got) != string(content) {
+					t.Errorf("unzipped %s = %q, %v, want %q", path, got, err, content)
+				}
+			}
+		})
+	}
+}
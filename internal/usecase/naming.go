@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/config"
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+	"github.com/ettle/strcase"
+)
+
+// goNamer converts Prisma names into Go identifiers, upper casing the
+// initialisms, e.g. userId becomes UserID.
+type goNamer struct {
+	caser *strcase.Caser
+}
+
+// newGoNamer returns a namer using the golint initialisms (ID, URL, API,
+// UUID, ...) extended with the configured ones. A configured initialism
+// prefixed with a dash, e.g. -API, is removed from the list instead.
+func newGoNamer(cfg config.Naming) goNamer {
+	initialisms := map[string]bool{}
+	for _, initialism := range cfg.Initialisms {
+		name, removed := strings.CutPrefix(initialism, "-")
+		initialisms[strings.ToUpper(name)] = !removed
+	}
+	return goNamer{caser: strcase.NewCaser(true, initialisms, nil)}
+}
+
+// exported returns name as an exported Go identifier in Pascal case.
+func (n goNamer) exported(name string) string {
+	return safeIdentifier(n.caser.ToPascal(name))
+}
+
+// typeName returns the Go type name of a model, view, composite type or
+// enum. Names that are already exported Go identifiers are kept as written,
+// others are converted to Pascal case, e.g. user_profiles becomes
+// UserProfiles.
+func (n goNamer) typeName(name string) string {
+	if token.IsIdentifier(name) && token.IsExported(name) && !strings.Contains(name, "_") {
+		return name
+	}
+	return n.exported(name)
+}
+
+// safeIdentifier makes ident a valid Go identifier: names that do not start
+// with a letter are prefixed with X and keywords, e.g. type, get a trailing
+// underscore.
+func safeIdentifier(ident string) string {
+	first, _ := utf8.DecodeRuneInString(ident)
+	switch {
+	case !unicode.IsLetter(first):
+		return "X" + ident
+	case token.IsKeyword(ident):
+		return ident + "_"
+	}
+	return ident
+}
+
+// goNames holds the Go identifiers of the elements of a schema, resolved
+// once so every generator names them the same way.
+type goNames struct {
+	namer  goNamer
+	models map[*schema.Model]string
+	fields map[*schema.Field]string
+	enums  map[*schema.Enum]string
+}
+
+// newGoNames names the enums, composite types, models and views of a schema
+// and their fields. Names set with @go.name are kept as is, their collisions
+// are reported by the generators. Derived names that collide with a name
+// taken before, in declaration order, get the first free numeric suffix,
+// e.g. UserID2 for userId declared after user_id, with a warning pointing
+// at the renamed element.
+func newGoNames(
+	prismaSchema *schema.Schema,
+	namer goNamer,
+) (goNames, schema.Diagnostics) {
+	names := goNames{
+		namer:  namer,
+		models: map[*schema.Model]string{},
+		fields: map[*schema.Field]string{},
+		enums:  map[*schema.Enum]string{},
+	}
+	var diags schema.Diagnostics
+
+	pkg := newNameScope(&diags)
+	for _, model := range slices.Concat(prismaSchema.Types, prismaSchema.Models, prismaSchema.Views) {
+		if annotation, ok := model.Annotation("go.name"); ok {
+			pkg.claim(annotation.Arg, fmt.Sprintf("%s %s", model.Kind, model.Name), model.Pos)
+			names.models[model] = annotation.Arg
+		}
+	}
+	for _, enum := range prismaSchema.Enums {
+		names.enums[enum] = pkg.derive(namer.typeName(enum.Name), "enum "+enum.Name, enum.Pos)
+	}
+	for _, model := range slices.Concat(prismaSchema.Types, prismaSchema.Models, prismaSchema.Views) {
+		if _, ok := names.models[model]; !ok {
+			names.models[model] = pkg.derive(
+				namer.typeName(model.Name),
+				fmt.Sprintf("%s %s", model.Kind, model.Name),
+				model.Pos,
+			)
+		}
+
+		fields := newNameScope(&diags)
+		for _, field := range model.Fields {
+			if annotation, ok := field.Annotation("go.name"); ok {
+				fields.claim(annotation.Arg, fmt.Sprintf("field %s.%s", model.Name, field.Name), field.Pos)
+				names.fields[field] = annotation.Arg
+			}
+		}
+		for _, field := range model.Fields {
+			if _, ok := names.fields[field]; !ok {
+				names.fields[field] = fields.derive(
+					namer.exported(field.Name),
+					fmt.Sprintf("field %s.%s", model.Name, field.Name),
+					field.Pos,
+				)
+			}
+		}
+	}
+
+	return names, diags
+}
+
+// model returns the Go identifier of a model, view or composite type.
+func (n goNames) model(model *schema.Model) string {
+	if name, ok := n.models[model]; ok {
+		return name
+	}
+	if annotation, ok := model.Annotation("go.name"); ok {
+		return annotation.Arg
+	}
+	return n.namer.typeName(model.Name)
+}
+
+// idType returns the name of the ID type of a model, e.g. UserID.
+func (n goNames) idType(model *schema.Model) string {
+	return n.model(model) + "ID"
+}
+
+// field returns the Go identifier of a field.
+func (n goNames) field(field *schema.Field) string {
+	if name, ok := n.fields[field]; ok {
+		return name
+	}
+	if annotation, ok := field.Annotation("go.name"); ok {
+		return annotation.Arg
+	}
+	return n.namer.exported(field.Name)
+}
+
+// enum returns the Go type name of an enum.
+func (n goNames) enum(enum *schema.Enum) string {
+	if name, ok := n.enums[enum]; ok {
+		return name
+	}
+	return n.namer.typeName(enum.Name)
+}
+
+// enumValue returns the name of the Go constant of an enum value.
+func (n goNames) enumValue(enum *schema.Enum, value *schema.EnumValue) string {
+	return n.enum(enum) + n.namer.exported(value.Name)
+}
+
+// nameScope resolves the collisions of the derived names of a scope.
+type nameScope struct {
+	taken map[string]goIdentifier
+	diags *schema.Diagnostics
+}
+
+func newNameScope(diags *schema.Diagnostics) *nameScope {
+	return &nameScope{taken: map[string]goIdentifier{}, diags: diags}
+}
+
+// claim takes a name set explicitly with @go.name.
+func (s *nameScope) claim(ident, source string, pos schema.Position) {
+	if _, ok := s.taken[ident]; !ok {
+		s.taken[ident] = goIdentifier{source: source, pos: pos}
+	}
+}
+
+// derive takes ident, or the first free ident with a numeric suffix when it
+// is already taken.
+func (s *nameScope) derive(ident, source string, pos schema.Position) string {
+	first, ok := s.taken[ident]
+	if !ok {
+		s.taken[ident] = goIdentifier{source: source, pos: pos}
+		return ident
+	}
+
+	renamed := ident
+	for i := 2; ; i++ {
+		renamed = ident + strconv.Itoa(i)
+		if _, ok := s.taken[renamed]; !ok {
+			break
+		}
+	}
+	s.taken[renamed] = goIdentifier{source: source, pos: pos}
+	s.diags.Warningf(
+		pos,
+		"%s generates Go identifier %s, like %s (declared at %s), so it is named %s, set @go.name to choose another name",
+		source,
+		ident,
+		first.source,
+		first.pos,
+		renamed,
+	)
+	return renamed
+}
+
+// goOmitted reports whether the field is left out of the generated Go code
+// with @go.omit.
+func goOmitted(field *schema.Field) bool {
+	_, ok := field.Annotation("go.omit")
+	return ok
+}
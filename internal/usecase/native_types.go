@@ -0,0 +1,96 @@
+package usecase
+
+// nativeType describes the Go representation of a @db.* native type.
+type nativeType struct {
+	goType string
+	// pgtype is the pgx pgtype used for the nullable form when the one
+	// picked from goType would be wrong, e.g. pgtype.Timestamp instead of
+	// pgtype.Timestamptz.
+	pgtype string
+}
+
+// postgresNativeTypes maps PostgreSQL and CockroachDB native types, by
+// Prisma scalar, to Go types.
+var postgresNativeTypes = map[string]map[string]nativeType{
+	"String": {
+		"Uuid":   {goType: "uuid.UUID"},
+		"Inet":   {goType: "Inet"},
+		"Cidr":   {goType: "Inet"},
+		"Xml":    {goType: "string"},
+		"Citext": {goType: "string"},
+	},
+	"Int": {
+		"Integer":  {goType: "int32"},
+		"Int4":     {goType: "int32"},
+		"SmallInt": {goType: "int16"},
+		"Int2":     {goType: "int16"},
+		"Oid":      {goType: "uint32"},
+	},
+	"BigInt": {
+		"BigInt": {goType: "int64"},
+		"Int8":   {goType: "int64"},
+	},
+	"Float": {
+		"Real":            {goType: "float32"},
+		"Float4":          {goType: "float32"},
+		"DoublePrecision": {goType: "float64"},
+		"Float8":          {goType: "float64"},
+	},
+	"DateTime": {
+		"Date":      {goType: "Date", pgtype: "pgtype.Date"},
+		"Time":      {goType: "TimeOfDay", pgtype: "pgtype.Time"},
+		"Timetz":    {goType: "string"},
+		"Timestamp": {goType: "time.Time", pgtype: "pgtype.Timestamp"},
+	},
+}
+
+// mysqlNativeTypes maps MySQL native types, by Prisma scalar, to Go types.
+var mysqlNativeTypes = map[string]map[string]nativeType{
+	"Int": {
+		"Int":               {goType: "int32"},
+		"UnsignedInt":       {goType: "uint32"},
+		"MediumInt":         {goType: "int32"},
+		"UnsignedMediumInt": {goType: "uint32"},
+		"SmallInt":          {goType: "int16"},
+		"UnsignedSmallInt":  {goType: "uint16"},
+		"TinyInt":           {goType: "int8"},
+		"UnsignedTinyInt":   {goType: "uint8"},
+		"Year":              {goType: "int16"},
+	},
+	"BigInt": {
+		"BigInt":         {goType: "int64"},
+		"UnsignedBigInt": {goType: "uint64"},
+	},
+	"Float": {
+		"Float":  {goType: "float32"},
+		"Double": {goType: "float64"},
+	},
+	"DateTime": {
+		"Date": {goType: "Date"},
+		"Time": {goType: "TimeOfDay"},
+	},
+}
+
+// mongodbNativeTypes maps MongoDB native types, by Prisma scalar, to Go
+// types.
+var mongodbNativeTypes = map[string]map[string]nativeType{
+	"String": {
+		"ObjectId": {goType: "primitive.ObjectID"},
+	},
+}
+
+// nativeTypesByProvider selects the native type table of a datasource
+// provider. SQLite has no native type attributes, so the scalar defaults
+// always apply. Schemas without a datasource use the PostgreSQL table, which
+// keeps @db.Uuid working as before.
+func nativeTypesByProvider(provider string) map[string]map[string]nativeType {
+	switch provider {
+	case "mysql":
+		return mysqlNativeTypes
+	case "mongodb":
+		return mongodbNativeTypes
+	case "postgresql", "cockroachdb", "":
+		return postgresNativeTypes
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/config"
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// typeOverrides holds the Go types configured for Prisma scalars, native
+// types and model fields.
+type typeOverrides struct {
+	scalars map[string]goFieldType
+	// natives is keyed by the native type name, e.g. Uuid, or by the scalar
+	// and native type names, e.g. String.Uuid, for overrides narrowed down
+	// to one scalar.
+	natives map[string]goFieldType
+	fields  map[*schema.Field]goFieldType
+	// packages maps the package qualifiers of both the built-in and the
+	// configured types to their import paths.
+	packages map[string]string
+}
+
+var (
+	majorVersionRegex = regexp.MustCompile(`^v[0-9]+$`)
+	gopkgVersionRegex = regexp.MustCompile(`\.v[0-9]+$`)
+)
+
+// packageName guesses the name of the package at an import path, which is
+// the qualifier its types are referenced with when imported without alias.
+func packageName(path string) string {
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && majorVersionRegex.MatchString(name) {
+		name = elems[len(elems)-2]
+	}
+	name = gopkgVersionRegex.ReplaceAllString(name, "")
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "go-"), "-go")
+	return strings.ReplaceAll(name, "-", "")
+}
+
+// splitImportPath splits a type expression qualified by a full import path,
+// e.g. github.com/shopspring/decimal.Decimal, into the expression used in
+// code, qualified by alias or the package name, and the import path.
+func splitImportPath(expr, alias string) (string, string) {
+	rest := strings.TrimLeft(expr, "*[]")
+	prefix := expr[:len(expr)-len(rest)]
+
+	slash := strings.LastIndex(rest, "/")
+	dot := strings.LastIndex(rest, ".")
+	if slash < 0 || dot < slash {
+		return expr, ""
+	}
+
+	path := rest[:dot]
+	if alias == "" {
+		alias = packageName(path)
+	}
+	return prefix + alias + rest[dot:], path
+}
+
+// newTypeOverrides resolves the type overrides of cfg against a schema.
+func newTypeOverrides(
+	cfg *config.Config,
+	prismaSchema *schema.Schema,
+) (typeOverrides, error) {
+	overrides := typeOverrides{
+		scalars:  map[string]goFieldType{},
+		natives:  map[string]goFieldType{},
+		fields:   map[*schema.Field]goFieldType{},
+		packages: maps.Clone(knownPackages),
+	}
+
+	// Register the configured packages first, so the types of one override
+	// may use the package imported by another.
+	configured := map[string]string{}
+	register := func(qualifier, path string) error {
+		if other, ok := configured[qualifier]; ok && other != path {
+			return fmt.Errorf(
+				"package name %s is used for both %s and %s, set an alias",
+				qualifier,
+				other,
+				path,
+			)
+		}
+		configured[qualifier] = path
+		overrides.packages[qualifier] = path
+		return nil
+	}
+
+	for i, override := range cfg.Types {
+		var err error
+		if override.Import != "" {
+			qualifier := override.Alias
+			if qualifier == "" {
+				qualifier = packageName(override.Import)
+			}
+			err = register(qualifier, override.Import)
+		}
+		for _, expr := range []string{override.Type, override.Nullable} {
+			if _, path := splitImportPath(expr, override.Alias); path != "" && err == nil {
+				qualifier := override.Alias
+				if qualifier == "" {
+					qualifier = packageName(path)
+				}
+				err = register(qualifier, path)
+			}
+		}
+		if err != nil {
+			return typeOverrides{}, fmt.Errorf("%s: types[%d]: %w", cfg.Path, i, err)
+		}
+	}
+
+	resolver := newTypeResolver(prismaSchema)
+	seen := map[string]int{}
+	for i, override := range cfg.Types {
+		target, err := overrides.add(override, prismaSchema, resolver)
+		if err == nil {
+			if first, ok := seen[target]; ok {
+				err = fmt.Errorf("duplicate override for %s (first set in types[%d])", target, first)
+			}
+			seen[target] = i
+		}
+		if err != nil {
+			return typeOverrides{}, fmt.Errorf("%s: types[%d]: %w", cfg.Path, i, err)
+		}
+	}
+
+	// Fields annotated with @go.type are field overrides declared in the
+	// schema itself.
+	for _, model := range slices.Concat(prismaSchema.Models, prismaSchema.Views, prismaSchema.Types) {
+		for _, field := range model.Fields {
+			annotation, ok := field.Annotation("go.type")
+			if !ok {
+				continue
+			}
+			if err := overrides.annotate(model, field, annotation.Arg, resolver); err != nil {
+				return typeOverrides{}, fmt.Errorf("%s: %w", field.Pos, err)
+			}
+		}
+	}
+
+	return overrides, nil
+}
+
+// annotate registers the @go.type annotation of a field.
+func (o typeOverrides) annotate(
+	model *schema.Model,
+	field *schema.Field,
+	expr string,
+	resolver typeResolver,
+) error {
+	if !resolver.isColumn(field) {
+		return fmt.Errorf(
+			"@go.type on field %s.%s, which is not a scalar or enum field",
+			model.Name,
+			field.Name,
+		)
+	}
+	if _, ok := o.fields[field]; ok {
+		return fmt.Errorf(
+			"field %s.%s has both @go.type and a type override in the config",
+			model.Name,
+			field.Name,
+		)
+	}
+
+	expr, path := splitImportPath(expr, "")
+	if path != "" {
+		qualifier := packageName(path)
+		if other, ok := o.packages[qualifier]; ok && other != path {
+			return fmt.Errorf(
+				"@go.type on field %s.%s imports %s as %s, which is already used for %s",
+				model.Name,
+				field.Name,
+				path,
+				qualifier,
+				other,
+			)
+		}
+		o.packages[qualifier] = path
+	}
+	if err := o.checkQualifiers(expr); err != nil {
+		return fmt.Errorf("@go.type on field %s.%s: %w", model.Name, field.Name, err)
+	}
+
+	o.fields[field] = goFieldType{base: expr}
+	return nil
+}
+
+// add registers a single override and returns a description of what it
+// applies to.
+func (o typeOverrides) add(
+	override config.TypeOverride,
+	prismaSchema *schema.Schema,
+	resolver typeResolver,
+) (string, error) {
+	fieldType := goFieldType{}
+	for _, typ := range []struct {
+		expr string
+		dst  *string
+	}{
+		{override.Type, &fieldType.base},
+		{override.Nullable, &fieldType.nullable},
+	} {
+		if typ.expr == "" {
+			continue
+		}
+		expr, _ := splitImportPath(typ.expr, override.Alias)
+		if err := o.checkQualifiers(expr); err != nil {
+			return "", err
+		}
+		*typ.dst = expr
+	}
+
+	if override.Scalar != "" && !schema.IsScalar(override.Scalar) {
+		return "", fmt.Errorf("unknown Prisma scalar %s", override.Scalar)
+	}
+
+	switch {
+	case override.Field != "":
+		modelName, fieldName := override.FieldPath()
+		model := prismaSchema.Model(modelName)
+		if model == nil {
+			model = prismaSchema.View(modelName)
+		}
+		if model == nil {
+			model = prismaSchema.Type(modelName)
+		}
+		if model == nil {
+			return "", fmt.Errorf("unknown model %s", modelName)
+		}
+		field := model.Field(fieldName)
+		if field == nil {
+			return "", fmt.Errorf("unknown field %s in %s %s", fieldName, model.Kind, modelName)
+		}
+		if !resolver.isColumn(field) {
+			return "", fmt.Errorf("field %s is not a scalar or enum field", override.Field)
+		}
+		o.fields[field] = fieldType
+		return "field " + override.Field, nil
+
+	case override.Native != "":
+		key := override.NativeName()
+		if override.Scalar != "" {
+			key = override.Scalar + "." + key
+		}
+		o.natives[key] = fieldType
+		return "native type " + key, nil
+
+	default:
+		o.scalars[override.Scalar] = fieldType
+		return "scalar " + override.Scalar, nil
+	}
+}
+
+// checkQualifiers reports package qualifiers of a type expression that have
+// no known import path.
+func (o typeOverrides) checkQualifiers(expr string) error {
+	var errs []error
+	for _, match := range qualifierRegex.FindAllStringSubmatch(expr, -1) {
+		if _, ok := o.packages[match[1]]; !ok {
+			errs = append(errs, fmt.Errorf(
+				"type %s uses package %s, which has no known import path, qualify it with the full import path or set import",
+				expr,
+				match[1],
+			))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// native returns the override for a native type of a scalar, preferring the
+// one narrowed down to the scalar.
+func (o typeOverrides) native(scalar, name string) (goFieldType, bool) {
+	if fieldType, ok := o.natives[scalar+"."+name]; ok {
+		return fieldType, true
+	}
+	fieldType, ok := o.natives[name]
+	return fieldType, ok
+}
@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"bufio"
+	"fmt"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// schemaPackage is a generated Go package and the part of the schema it is
+// generated from.
+type schemaPackage struct {
+	name string
+	dir  string
+	// importPath is the import path of dir, empty when it is not part of a
+	// Go module.
+	importPath string
+	schema     *schema.Schema
+}
+
+// schemaPackages splits the schema into the Go packages generated in outDir:
+// a single package named after outDir or, with perSchema, one subpackage of
+// outDir per database schema (@@schema), the elements without a schema
+// staying in outDir.
+func schemaPackages(
+	prismaSchema *schema.Schema,
+	outDir string,
+	perSchema bool,
+) ([]schemaPackage, error) {
+	root := schemaPackage{
+		name:   filepath.Base(outDir),
+		dir:    outDir,
+		schema: prismaSchema,
+	}
+	if !perSchema {
+		return []schemaPackage{root}, nil
+	}
+
+	root.schema = prismaSchema.InDatabaseSchema("")
+	packages := []schemaPackage{}
+	if len(root.schema.Models)+len(root.schema.Views)+len(root.schema.Enums) > 0 {
+		packages = append(packages, root)
+	}
+
+	modulePath, moduleDir := findModule(outDir)
+	seen := map[string]string{}
+	for _, dbSchema := range databaseSchemas(prismaSchema) {
+		name := goPackageName(dbSchema)
+		if other, ok := seen[name]; ok {
+			return nil, fmt.Errorf(
+				"database schemas %q and %q both generate package %s",
+				other,
+				dbSchema,
+				name,
+			)
+		}
+		seen[name] = dbSchema
+
+		pkg := schemaPackage{
+			name:   name,
+			dir:    filepath.Join(outDir, name),
+			schema: prismaSchema.InDatabaseSchema(dbSchema),
+		}
+		if modulePath != "" {
+			pkg.importPath = importPath(modulePath, moduleDir, pkg.dir)
+		}
+		packages = append(packages, pkg)
+	}
+
+	if len(packages) == 0 {
+		return []schemaPackage{root}, nil
+	}
+	return packages, nil
+}
+
+// checkImportCycles reports the packages that import each other, directly
+// or through other packages, because of the enums and ID types their models
+// and views use, as Go does not allow import cycles.
+func checkImportCycles(packages []schemaPackage, resolver typeResolver) error {
+	// A package imports another one for the first of its fields using it
+	imports := map[string][]packageImport{}
+	for _, pkg := range packages {
+		seen := map[string]bool{}
+		for _, imp := range resolver.withPackage(pkg, packages).packageImports(pkg.schema) {
+			if !seen[imp.pkg.dir] {
+				seen[imp.pkg.dir] = true
+				imports[pkg.dir] = append(imports[pkg.dir], imp)
+			}
+		}
+	}
+
+	var diags schema.Diagnostics
+	visited := map[string]bool{}
+	// stack holds the packages being visited, path the imports between them
+	var stack []schemaPackage
+	var path []packageImport
+	var visit func(pkg schemaPackage)
+	visit = func(pkg schemaPackage) {
+		visited[pkg.dir] = true
+		stack = append(stack, pkg)
+		for _, imp := range imports[pkg.dir] {
+			start := slices.IndexFunc(stack, func(other schemaPackage) bool {
+				return other.dir == imp.pkg.dir
+			})
+			if start >= 0 {
+				reportImportCycle(&diags, stack[start:], append(slices.Clone(path[start:]), imp))
+				continue
+			}
+			if !visited[imp.pkg.dir] {
+				path = append(path, imp)
+				visit(imp.pkg)
+				path = path[:len(path)-1]
+			}
+		}
+		stack = stack[:len(stack)-1]
+	}
+	for _, pkg := range packages {
+		if !visited[pkg.dir] {
+			visit(pkg)
+		}
+	}
+
+	if diags.HasErrors() {
+		return diags.Sorted()
+	}
+	return nil
+}
+
+// reportImportCycle reports the packages of a cycle at the first field
+// making them import each other.
+func reportImportCycle(
+	diags *schema.Diagnostics,
+	packages []schemaPackage,
+	imports []packageImport,
+) {
+	names := make([]string, 0, len(packages))
+	for _, pkg := range packages {
+		names = append(names, pkg.name)
+	}
+	fields := make([]string, 0, len(imports))
+	for _, imp := range imports {
+		fields = append(fields, imp.String())
+	}
+	diags.Errorf(
+		imports[0].field.Pos,
+		"packages %s import each other, which Go does not allow: %s; move these enums and models to the same database schema",
+		strings.Join(names, ", "),
+		strings.Join(fields, ", "),
+	)
+}
+
+// databaseSchemas returns the database schemas used by the schema, in the
+// order the datasource lists them.
+func databaseSchemas(prismaSchema *schema.Schema) []string {
+	used := map[string]bool{}
+	for _, model := range prismaSchema.Models {
+		used[model.SchemaName()] = true
+	}
+	for _, view := range prismaSchema.Views {
+		used[view.SchemaName()] = true
+	}
+	for _, enum := range prismaSchema.Enums {
+		used[enum.SchemaName()] = true
+	}
+
+	var schemas []string
+	for _, name := range prismaSchema.DatabaseSchemas() {
+		if used[name] {
+			schemas = append(schemas, name)
+		}
+	}
+	return schemas
+}
+
+// goPackageName returns a valid Go package name for a database schema.
+func goPackageName(dbSchema string) string {
+	name := strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if r == '_' || ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return -1
+	}, dbSchema)
+
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "schema" + name
+	}
+	if token.IsKeyword(name) {
+		name += "schema"
+	}
+	return name
+}
+
+// findModule returns the module path and directory of the Go module dir
+// belongs to, looking for a go.mod file in dir and its parents.
+func findModule(dir string) (string, string) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", ""
+	}
+
+	for {
+		if modulePath := readModulePath(filepath.Join(dir, "go.mod")); modulePath != "" {
+			return modulePath, dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ""
+		}
+		dir = parent
+	}
+}
+
+// importPath returns the import path of dir in the module at moduleDir.
+func importPath(modulePath, moduleDir, dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	rel, err := filepath.Rel(moduleDir, dir)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	return path.Join(modulePath, filepath.ToSlash(rel))
+}
+
+func readModulePath(goMod string) string {
+	file, err := os.Open(goMod)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if modulePath, ok := strings.CutPrefix(line, "module "); ok {
+			return strings.Trim(strings.TrimSpace(modulePath), `"`)
+		}
+	}
+	return ""
+}
@@ -1,211 +1,643 @@
 package usecase
 
 import (
-	"bufio"
+	"cmp"
+	"errors"
 	"fmt"
-	"go/format"
-	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"text/template"
 
-	"github.com/ettle/strcase"
+	"github.com/danielmesquitta/prisma-go-tools/internal/config"
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
 )
 
+var structTagRegex = regexp.MustCompile(`([A-Za-z_][\w-]*):"(?:[^"\\]|\\.)*"`)
+
+// JSONTagSource selects what the json struct tags of the entities are
+// derived from.
+type JSONTagSource string
+
+const (
+	// JSONTagField uses the Prisma field name, e.g. `json:"createdAt"`.
+	JSONTagField JSONTagSource = "field"
+	// JSONTagColumn uses the database column name (@map), e.g.
+	// `json:"created_at"`.
+	JSONTagColumn JSONTagSource = "column"
+	// JSONTagCamel uses the camelCase Prisma field name, e.g.
+	// `json:"createdAt"` for created_at.
+	JSONTagCamel JSONTagSource = "camel"
+	// JSONTagSnake uses the snake_case Prisma field name, e.g.
+	// `json:"created_at"` for createdAt.
+	JSONTagSnake JSONTagSource = "snake"
+)
+
+// NullableMode selects how optional fields are represented in the entities.
+type NullableMode string
+
+const (
+	// NullablePointer uses pointers, e.g. *string.
+	NullablePointer NullableMode = "pointer"
+	// NullableSQL uses the database/sql Null types, e.g. sql.NullString,
+	// falling back to sql.Null[T].
+	NullableSQL NullableMode = "sql"
+	// NullablePgtype uses the pgx pgtype types, e.g. pgtype.Text, falling
+	// back to pointers for types pgtype has no equivalent for.
+	NullablePgtype NullableMode = "pgtype"
+	// NullableGeneric uses the Null[T] wrapper generated in the package.
+	NullableGeneric NullableMode = "generic"
+)
+
+// DecimalMode selects the Go type of Decimal fields.
+type DecimalMode string
+
+const (
+	// DecimalString keeps the exact value as a string.
+	DecimalString DecimalMode = "string"
+	// DecimalShopspring uses github.com/shopspring/decimal.Decimal.
+	DecimalShopspring DecimalMode = "shopspring"
+	// DecimalFloat64 uses float64, which may lose precision.
+	DecimalFloat64 DecimalMode = "float64"
+)
+
+// RelationsMode selects how the relations between models are generated in
+// the entities.
+type RelationsMode string
+
+const (
+	// RelationsNone leaves the relation fields out.
+	RelationsNone RelationsMode = "none"
+	// RelationsMetadata only generates the Relations method describing the
+	// relations of every model.
+	RelationsMetadata RelationsMode = "metadata"
+	// RelationsFields also generates the relation fields in the structs,
+	// tagged db:"-", e.g. Posts []Post in User.
+	RelationsFields RelationsMode = "fields"
+	// RelationsAggregates also generates a struct per relation field
+	// embedding the model, e.g. UserWithPosts.
+	RelationsAggregates RelationsMode = "aggregates"
+)
+
+// hasFields reports whether the relation fields are generated, in the
+// structs of the models or in aggregates.
+func (m RelationsMode) hasFields() bool {
+	return m == RelationsFields || m == RelationsAggregates
+}
+
+// EntitiesOptions configures the Go structs generated from the schema. Unset
+// options are read from the configuration file, then fall back to their
+// defaults.
+type EntitiesOptions struct {
+	JSONTag  JSONTagSource
+	Nullable NullableMode
+	Decimal  DecimalMode
+	// Tags lists the struct tag profiles of the fields, defaulting to sqlx
+	// and json, plus bson for MongoDB.
+	Tags []TagProfile
+	// TagTemplate is a text/template rendering the struct tags of every
+	// field, replacing Tags.
+	TagTemplate string
+	// Relations selects how relations are generated, defaulting to none.
+	Relations RelationsMode
+	// IDTypes generates an ID type per model, e.g. UserID, typing its
+	// primary key and the relation scalar fields referencing it.
+	IDTypes bool
+	// IncludeIgnored also generates the models, views and fields marked
+	// with @@ignore or @ignore.
+	IncludeIgnored bool
+	// PackagePerSchema generates the entities of every database schema
+	// (@@schema) in their own package, in a subdirectory of the output
+	// directory.
+	PackagePerSchema bool
+	// ConfigPath is the configuration file with the type overrides and the
+	// defaults of the options above. When empty, the file is looked up in
+	// the working directory.
+	ConfigPath string
+}
+
+// withConfig fills the unset options from the configuration file and the
+// defaults.
+func (o EntitiesOptions) withConfig(cfg config.Entities) EntitiesOptions {
+	if len(o.Tags) == 0 && o.TagTemplate == "" {
+		for _, tag := range cfg.Tags {
+			o.Tags = append(o.Tags, TagProfile(tag))
+		}
+		o.TagTemplate = cfg.TagTemplate
+	}
+
+	o.JSONTag = cmp.Or(o.JSONTag, JSONTagSource(cfg.JSONTag), JSONTagField)
+	o.Nullable = cmp.Or(o.Nullable, NullableMode(cfg.Nullable), NullablePointer)
+	o.Decimal = cmp.Or(o.Decimal, DecimalMode(cfg.Decimal), DecimalString)
+	o.Relations = cmp.Or(o.Relations, RelationsMode(cfg.Relations), RelationsNone)
+	o.IDTypes = o.IDTypes || cfg.IDTypes
+	return o
+}
+
+func (o EntitiesOptions) validate() error {
+	switch o.JSONTag {
+	case JSONTagField, JSONTagColumn, JSONTagCamel, JSONTagSnake:
+	default:
+		return fmt.Errorf(
+			"invalid json tag source %q, expected one of %q, %q, %q or %q",
+			o.JSONTag,
+			JSONTagField,
+			JSONTagColumn,
+			JSONTagCamel,
+			JSONTagSnake,
+		)
+	}
+
+	if len(o.Tags) > 0 && o.TagTemplate != "" {
+		return errors.New("tag profiles cannot be combined with a tag template")
+	}
+	for _, profile := range o.Tags {
+		if !slices.Contains(tagProfiles, profile) {
+			return fmt.Errorf(
+				"invalid tag profile %q, expected one of %s",
+				profile,
+				strings.Join(tagProfileNames(), ", "),
+			)
+		}
+	}
+	if _, err := parseTagTemplate(o.TagTemplate); err != nil {
+		return err
+	}
+
+	switch o.Nullable {
+	case NullablePointer, NullableSQL, NullablePgtype, NullableGeneric:
+	default:
+		return fmt.Errorf(
+			"invalid nullable mode %q, expected one of %q, %q, %q or %q",
+			o.Nullable,
+			NullablePointer,
+			NullableSQL,
+			NullablePgtype,
+			NullableGeneric,
+		)
+	}
+
+	if _, ok := decimalTypes[o.Decimal]; !ok {
+		return fmt.Errorf(
+			"invalid decimal mode %q, expected one of %q, %q or %q",
+			o.Decimal,
+			DecimalString,
+			DecimalShopspring,
+			DecimalFloat64,
+		)
+	}
+
+	switch o.Relations {
+	case RelationsNone, RelationsMetadata, RelationsFields, RelationsAggregates:
+	default:
+		return fmt.Errorf(
+			"invalid relations mode %q, expected one of %q, %q, %q or %q",
+			o.Relations,
+			RelationsNone,
+			RelationsMetadata,
+			RelationsFields,
+			RelationsAggregates,
+		)
+	}
+
+	return nil
+}
+
+// validateProvider checks the options against the datasource provider of
+// the schema.
+func (o EntitiesOptions) validateProvider(provider string) error {
+	if provider != "mongodb" {
+		return nil
+	}
+	if o.IDTypes {
+		return errors.New("ID types are not supported for MongoDB, the BSON codecs do not apply to types defined over primitive.ObjectID")
+	}
+	if o.Nullable != NullablePointer {
+		return fmt.Errorf(
+			"nullable mode %q is not supported for MongoDB, the BSON codecs only decode optional fields into pointers",
+			o.Nullable,
+		)
+	}
+	return nil
+}
+
 func PrismaToGoStructs(
 	schemaPath, outDir string,
-) (outFile string, err error) {
-	return processSchema(schemaPath, outDir)
+	opts EntitiesOptions,
+) (outFiles []string, err error) {
+	cfg, err := config.Load(opts.ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = opts.withConfig(cfg.Entities)
+	if err := opts.validate(); err != nil {
+		if cfg.Path != "" {
+			return nil, fmt.Errorf("%w (options may be set in %s)", err, cfg.Path)
+		}
+		return nil, err
+	}
+	return processSchema(schemaPath, outDir, cfg, opts)
 }
 
-// Parse a Prisma model into a Go struct
-func parseModel(lines []string) (string, bool, bool) {
-	structName := ""
-	fields := []string{}
-	modelRegex := regexp.MustCompile(`model\s+(\w+)`)
-	fieldRegex := regexp.MustCompile(`\s*(\w+)\s+(\w+)(\[\])?\s*(\?)?.*`)
-	uuidRegex := regexp.MustCompile(`@db\.Uuid`)
-	usesTime := false
-	usesUUID := false
-
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if modelMatch := modelRegex.FindStringSubmatch(line); modelMatch != nil {
-			structName = modelMatch[1]
-		} else if fieldMatch := fieldRegex.FindStringSubmatch(line); fieldMatch != nil {
-			fieldName := strcase.ToGoPascal(fieldMatch[1])
-
-			fieldType := typeMap[fieldMatch[2]]
-			if uuidRegex.MatchString(line) {
-				fieldType = "uuid.UUID"
-				usesUUID = true
-			}
+// checkStructNames reports schema elements whose generated Go identifiers
+// collide in the entities package.
+func checkStructNames(
+	prismaSchema *schema.Schema,
+	names goNames,
+	cfg *config.Config,
+	opts EntitiesOptions,
+) schema.Diagnostics {
+	var diags schema.Diagnostics
 
-			// Do not include relationships
-			if _, ok := typeMap[fieldMatch[2]]; !ok {
-				continue
+	// Invalid type overrides are reported when generating the entities
+	resolver, err := newEntitiesResolver(prismaSchema, names, cfg, opts)
+	if err != nil {
+		resolver = newTypeResolver(prismaSchema).withNames(names)
+	}
+	ids := resolver.idTypes
+	pkg := newGoScope("the entities package", &diags)
+	pkg.reserve("generated helper types", fieldHelpers(prismaSchema, resolver, opts.Nullable).identifiers()...)
+	if opts.Relations != RelationsNone {
+		pkg.reserve("generated relation types", relationIdentifiers...)
+	}
+	for _, enum := range prismaSchema.Enums {
+		for _, ident := range enumIdentifiers(enum, names) {
+			pkg.declare(ident, "enum "+enum.Name, enum.Pos)
+		}
+		for _, value := range enum.Values {
+			pkg.declare(
+				names.enumValue(enum, value),
+				fmt.Sprintf("enum value %s.%s", enum.Name, value.Name),
+				value.Pos,
+			)
+		}
+	}
+
+	for _, model := range slices.Concat(prismaSchema.Types, prismaSchema.Models, prismaSchema.Views) {
+		modelName := names.model(model)
+		pkg.declare(modelName, fmt.Sprintf("%s %s", model.Kind, model.Name), model.Pos)
+
+		fields := newGoScope("struct "+modelName, &diags)
+		if model.Kind != schema.TypeBlock {
+			fields.reserve("generated TableName method", "TableName")
+			fields.reserve("generated key methods", keyMethods...)
+		}
+		if ids.owns(model) {
+			pkg.declare(names.idType(model), "ID type of model "+model.Name, model.Pos)
+		}
+		if model.Kind == schema.ModelBlock {
+			pkg.declare("New"+modelName, "constructor of model "+model.Name, model.Pos)
+			fields.reserve("generated DatabaseDefaults method", "DatabaseDefaults")
+			if opts.Relations != RelationsNone {
+				fields.reserve("generated Relations method", "Relations")
 			}
+		}
+		for _, field := range model.Fields {
+			if _, ok := resolver.entityType(field); !ok {
+				if !opts.Relations.hasFields() {
+					continue
+				}
+				if _, ok := relationFieldType(field, resolver); !ok {
+					continue
+				}
+				if opts.Relations == RelationsAggregates {
+					pkg.declare(
+						aggregateName(names, model, field),
+						fmt.Sprintf("aggregate of field %s.%s", model.Name, field.Name),
+						field.Pos,
+					)
+				}
+			}
+			fields.declare(
+				names.field(field),
+				fmt.Sprintf("field %s.%s", model.Name, field.Name),
+				field.Pos,
+			)
+		}
+	}
 
-			// Add list or pointer handling
-			if fieldMatch[3] == "[]" {
-				fieldType = "[]" + fieldType // Only apply once for list types
-			} else if fieldMatch[4] == "?" {
-				fieldType = "*" + fieldType
+	return diags
+}
+
+// mergeTags appends the @go.tag struct tags of a field to the generated
+// ones, replacing the generated tags with the same key.
+func mergeTags(tags string, extra []string) string {
+	if len(extra) == 0 {
+		return tags
+	}
+
+	pairs := structTagRegex.FindAllStringSubmatch(tags, -1)
+	for _, custom := range extra {
+		for _, pair := range structTagRegex.FindAllStringSubmatch(custom, -1) {
+			index := slices.IndexFunc(pairs, func(existing []string) bool {
+				return existing[1] == pair[1]
+			})
+			if index >= 0 {
+				pairs[index] = pair
+			} else {
+				pairs = append(pairs, pair)
 			}
+		}
+	}
+
+	merged := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		merged = append(merged, pair[0])
+	}
+	return strings.Join(merged, " ")
+}
+
+// isAutoDefault reports whether the field defaults to @default(auto()).
+func isAutoDefault(field *schema.Field) bool {
+	attr := field.Attribute("default")
+	if attr == nil {
+		return false
+	}
+	arg := attr.Arg(0, "value")
+	if arg == nil {
+		return false
+	}
+	call, ok := arg.Value.(*schema.FuncCall)
+	return ok && call.Name == "auto"
+}
 
-			if fieldType == "time.Time" {
-				usesTime = true
+// structFieldType returns the Go type of a struct field, with list or
+// nullable handling.
+func structFieldType(
+	field *schema.Field,
+	fieldType goFieldType,
+	mode NullableMode,
+) string {
+	switch {
+	case field.Type.List:
+		return "[]" + fieldType.base // Only apply once for list types
+	case field.Type.Optional:
+		return nullableType(fieldType, mode)
+	}
+	return fieldType.base
+}
+
+// Parse a Prisma model or composite type into a Go struct
+func parseModel(
+	model *schema.Model,
+	resolver typeResolver,
+	imports goImports,
+	helpers goHelpers,
+	tagTemplate *template.Template,
+	opts EntitiesOptions,
+) (string, error) {
+	fields := []string{}
+	modelName := resolver.names.model(model)
+
+	for _, field := range model.Fields {
+		fieldName := resolver.names.field(field)
+
+		// Relationships are only included as fields in the fields mode
+		fieldType, ok := resolver.entityType(field)
+		if !ok {
+			if opts.Relations != RelationsFields {
+				continue
 			}
+			goType, ok := relationFieldType(field, resolver)
+			if !ok {
+				continue
+			}
+			structField, err := parseRelationField(model, field, goType, resolver, tagTemplate, opts)
+			if err != nil {
+				return "", err
+			}
+			fields = append(fields, structField)
+			continue
+		}
 
-			fields = append(fields, fmt.Sprintf("\t%s %s `db:\"%s\" json:\"%s,omitempty\"`", fieldName, fieldType, fieldMatch[1], fieldMatch[1]))
+		goType := structFieldType(field, fieldType, opts.Nullable)
+
+		imports.addType(goType, resolver.packages)
+		// Enums and composite types may be named like the helper types
+		if resolver.kind(field) == scalarField {
+			helpers.addType(goType)
+		}
+
+		tags, err := structTags(
+			newTagField(model, field, fieldName, goType, opts.JSONTag, resolver.schema),
+			opts.Tags,
+			tagTemplate,
+		)
+		if err != nil {
+			return "", err
+		}
+		tags = mergeTags(tags, field.Tags())
+
+		fields = append(fields, goDocComment(fieldName, field.Doc, "\t")+fmt.Sprintf("\t%s %s `%s`", fieldName, goType, tags))
+	}
+
+	if model.Kind == schema.TypeBlock {
+		return fmt.Sprintf(
+			"%[3]stype %[1]s struct {\n%[2]s\n}",
+			modelName,
+			strings.Join(fields, "\n"),
+			goDocComment(modelName, model.Doc, ""),
+		), nil
+	}
+
+	// Views are read-only, which is stated in the struct documentation
+	relation, doc := "table", model.Doc
+	if model.Kind == schema.ViewBlock {
+		relation = "view"
+		note := fmt.Sprintf(
+			"%s is read-only, it maps the database view %q.",
+			modelName,
+			model.DBName(),
+		)
+		if doc != "" {
+			note = doc + "\n\n" + note
 		}
+		doc = note
 	}
 
+	// Expose the physical table name (@@map if present, otherwise model name)
 	structDefinition := fmt.Sprintf(
-		"type %s struct {\n%s\n}",
-		structName,
+		"%[4]stype %[1]s struct {\n%[2]s\n}\n\n"+
+			"// TableName returns the name of the database %[5]s of %[1]s.\n"+
+			"func (%[1]s) TableName() string {\n\treturn %[3]q\n}",
+		modelName,
 		strings.Join(fields, "\n"),
+		model.DBName(),
+		goDocComment(modelName, doc, ""),
+		relation,
 	)
-	return structDefinition, usesTime, usesUUID
+	if resolver.idTypes.owns(model) {
+		structDefinition = parseIDType(model, resolver, imports, helpers) + "\n\n" + structDefinition
+	}
+	structDefinition += "\n\n" + parseKeys(model, relation, resolver)
+	if model.Kind != schema.ModelBlock {
+		return structDefinition, nil
+	}
+	structDefinition += "\n\n" + parseConstructor(model, resolver, imports, helpers, opts)
+	if opts.Relations != RelationsNone {
+		relations := modelRelations(resolver.schema, model)
+		structDefinition += "\n\n" + parseRelations(model, relations, resolver, helpers)
+	}
+	if opts.Relations == RelationsAggregates {
+		aggregates, err := parseAggregates(model, resolver, tagTemplate, opts)
+		if err != nil {
+			return "", err
+		}
+		if aggregates != "" {
+			structDefinition += "\n\n" + aggregates
+		}
+	}
+	return structDefinition, nil
 }
 
-// Parse a Prisma enum into a Go type
-func parseEnum(lines []string) string {
-	enumName := ""
-	values := []string{}
-	enumRegex := regexp.MustCompile(`enum\s+(\w+)`)
-	valueRegex := regexp.MustCompile(`^\s*(\w+)`)
-
-	// Parse enum name and values and add to type map
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if enumMatch := enumRegex.FindStringSubmatch(line); enumMatch != nil {
-			enumName = enumMatch[1]
-			typeMap[enumName] = enumName
-		} else if valueMatch := valueRegex.FindStringSubmatch(line); valueMatch != nil {
-			value := valueMatch[1]
-			values = append(values, value)
-		}
-	}
-
-	// Generate Go enum type and constants
-	var enumDef strings.Builder
-	enumDef.WriteString(fmt.Sprintf("type %s string\n\nconst (\n", enumName))
-	for _, value := range values {
-		enumDef.WriteString(
-			fmt.Sprintf(
-				"\t%s%s %s = \"%s\"\n",
-				enumName,
-				strcase.ToGoPascal(value),
-				enumName,
-				value,
-			),
+// Reads and processes the Prisma schema file
+func processSchema(
+	filePath, outDir string,
+	cfg *config.Config,
+	opts EntitiesOptions,
+) ([]string, error) {
+	prismaSchema, names, err := loadSchema(
+		filePath,
+		opts.IncludeIgnored,
+		cfg.Naming,
+		func(s *schema.Schema, names goNames) schema.Diagnostics {
+			return checkStructNames(s, names, cfg, opts)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing schema: %w", err)
+	}
+
+	if len(opts.Tags) == 0 && opts.TagTemplate == "" {
+		opts.Tags = defaultTagProfiles(prismaSchema.Provider())
+	}
+	if err := opts.validateProvider(prismaSchema.Provider()); err != nil {
+		return nil, err
+	}
+
+	resolver, err := newEntitiesResolver(prismaSchema, names, cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := schemaPackages(prismaSchema, outDir, opts.PackagePerSchema)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkImportCycles(packages, resolver); err != nil {
+		return nil, err
+	}
+
+	outputFiles := make([]string, 0, len(packages))
+	for _, pkg := range packages {
+		outputFilePath, err := generateEntities(
+			pkg,
+			resolver.withPackage(pkg, packages),
+			opts,
 		)
+		if err != nil {
+			return nil, err
+		}
+		outputFiles = append(outputFiles, outputFilePath)
 	}
-	enumDef.WriteString(")\n")
 
-	return enumDef.String()
+	return outputFiles, nil
 }
 
-// Reads and processes the Prisma schema file
-func processSchema(filePath, outDir string) (string, error) {
-	file, err := os.Open(filePath)
+// newEntitiesResolver returns the resolver of the Go types of the entities
+// fields, applying the type overrides of cfg and the options.
+func newEntitiesResolver(
+	prismaSchema *schema.Schema,
+	names goNames,
+	cfg *config.Config,
+	opts EntitiesOptions,
+) (typeResolver, error) {
+	overrides, err := newTypeOverrides(cfg, prismaSchema)
 	if err != nil {
-		return "", fmt.Errorf("error opening file: %w", err)
-	}
-	defer file.Close()
-
-	var lines []string
-	var blocks [][]string
-	var result strings.Builder
-	scanner := bufio.NewScanner(file)
-	inBlock := false
-
-	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.HasPrefix(strings.TrimSpace(line), "model ") ||
-			strings.HasPrefix(strings.TrimSpace(line), "enum ") {
-			if inBlock {
-				blocks = append(blocks, lines)
-			}
-			inBlock = true
-			lines = []string{line}
-		} else if inBlock {
-			lines = append(lines, line)
-		}
+		return typeResolver{}, err
 	}
-	if inBlock {
-		blocks = append(blocks, lines)
+
+	resolver := newTypeResolver(prismaSchema).
+		withDecimal(opts.Decimal).
+		withOverrides(overrides).
+		withNames(names)
+	if opts.IDTypes {
+		resolver = resolver.withIDTypes(newIDTypes(prismaSchema, resolver))
 	}
+	return resolver, nil
+}
 
-	usesTime := false
-	usesUUID := false
+// generateEntities writes the entities of a package.
+func generateEntities(
+	pkg schemaPackage,
+	resolver typeResolver,
+	opts EntitiesOptions,
+) (string, error) {
+	var result goSource
+	imports := goImports{}
+	helpers := goHelpers{}
+	prismaSchema := pkg.schema
 
-	// First, parse enums
-	for _, block := range blocks {
-		if strings.HasPrefix(strings.TrimSpace(block[0]), "enum ") {
-			enumDef := parseEnum(block)
-			result.WriteString(enumDef)
-			result.WriteString("\n\n")
-		}
+	tagTemplate, err := parseTagTemplate(opts.TagTemplate)
+	if err != nil {
+		return "", err
 	}
 
-	// Next, parse models
-	for _, block := range blocks {
-		if strings.HasPrefix(strings.TrimSpace(block[0]), "model ") {
-			structDef, timeUsed, uuidUsed := parseModel(block)
-			result.WriteString(structDef)
-			result.WriteString("\n\n")
+	if err := resolver.checkPackageImports(prismaSchema); err != nil {
+		return "", err
+	}
 
-			if timeUsed {
-				usesTime = true
-			}
-			if uuidUsed {
-				usesUUID = true
-			}
+	// First, parse enums
+	for _, enum := range prismaSchema.Enums {
+		enumDef := parseEnum(enum, resolver.names, imports)
+		result.writeChunk(enumDef+"\n\n", "enum "+enum.Name, enum.Pos)
+	}
+
+	// Next, parse composite types, models and views
+	for _, model := range slices.Concat(prismaSchema.Types, prismaSchema.Models, prismaSchema.Views) {
+		structDef, err := parseModel(model, resolver, imports, helpers, tagTemplate, opts)
+		if err != nil {
+			return "", err
 		}
+		result.writeChunk(
+			structDef+"\n\n",
+			fmt.Sprintf("%s %s", model.Kind, model.Name),
+			model.Pos,
+		)
+	}
+
+	if opts.Nullable == NullableGeneric {
+		helpers.add("Null")
 	}
+	result.write(helpers.String(imports))
 
-	// Determine package name and output file name
-	outDirBase := filepath.Base(outDir)
+	// Determine output file name from the package name
 	outputFilePath := filepath.Join(
-		outDir,
-		fmt.Sprintf("%s_gen.go", outDirBase),
+		pkg.dir,
+		fmt.Sprintf("%s_gen.go", pkg.name),
 	)
 
-	// Create import block
-	imports := ""
-	if usesTime || usesUUID {
-		imports = "import (\n"
-		if usesTime {
-			imports += "\t\"time\"\n"
-		}
-		if usesUUID {
-			imports += "\t\"github.com/google/uuid\"\n"
-		}
-		imports += ")\n\n"
-	}
-
 	// Create the full output content
-	finalOutput := fmt.Sprintf(
-		"// Code generated by prisma-go-tools. DO NOT EDIT.\n\npackage %s\n\n%s%s",
-		outDirBase,
+	header := fmt.Sprintf(
+		"// Code generated by prisma-go-tools. DO NOT EDIT.\n\npackage %s\n\n%s",
+		pkg.name,
 		imports,
-		result.String(),
 	)
 
-	formatted, err := format.Source([]byte(finalOutput))
+	formatted, err := result.format(outputFilePath, header)
 	if err != nil {
-		return "", fmt.Errorf("error formatting generated code: %w", err)
+		return "", err
 	}
 
-	err = writeToFile(outDir, outputFilePath, string(formatted))
+	err = writeToFile(pkg.dir, outputFilePath, string(formatted))
 	if err != nil {
 		return "", fmt.Errorf("error creating output file: %w", err)
 	}
 
 	if err := formatGoFile(outputFilePath); err != nil {
-		return "", fmt.Errorf("error formatting file: %w", err)
+		return "", fmt.Errorf("error running gofmt on %s: %w", outputFilePath, err)
 	}
 
 	return outputFilePath, nil
@@ -1,167 +1,309 @@
 package usecase
 
 import (
-	"bufio"
 	"fmt"
 	"maps"
-	"os"
 	"path/filepath"
-	"regexp"
 	"slices"
 	"strings"
 
-	"github.com/ettle/strcase"
+	"github.com/danielmesquitta/prisma-go-tools/internal/config"
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
 )
 
+// TablesOptions configures the table helpers generated from the schema.
+type TablesOptions struct {
+	// IncludeIgnored also generates the models, views and fields marked
+	// with @@ignore or @ignore.
+	IncludeIgnored bool
+	// PackagePerSchema generates the tables of every database schema
+	// (@@schema) in their own package, in a subdirectory of the output
+	// directory.
+	PackagePerSchema bool
+	// ConfigPath is the configuration file with the naming options and the
+	// entities options the Go types of the columns are resolved with. When
+	// empty, the file is looked up in the working directory.
+	ConfigPath string
+}
+
 func PrismaToSQLTables(
 	schemaPath, outDir string,
-) (string, error) {
-	outputFilePath := filepath.Join(outDir, "table_gen.go")
-
-	// Extract table names and columns
-	tables, err := extractTableNames(schemaPath)
+	opts TablesOptions,
+) ([]string, error) {
+	cfg, err := config.Load(opts.ConfigPath)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	columns, err := extractColumnNames(schemaPath, tables)
+	prismaSchema, names, err := loadSchema(
+		schemaPath,
+		opts.IncludeIgnored,
+		cfg.Naming,
+		checkTableNames,
+	)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	packageName := filepath.Base(outDir)
-
-	// Generate the Go file content
-	goFileContent := generateGoFileContent(packageName, tables, columns)
-
-	// Write the content to the output Go file
-	if err := writeToFile(outDir, outputFilePath, goFileContent); err != nil {
-		return "", err
+	// Columns carry the Go type of their entity field, generated with the
+	// entities section of the configuration file
+	typeOpts := EntitiesOptions{}.withConfig(cfg.Entities)
+	if err := typeOpts.validate(); err != nil {
+		return nil, err
 	}
-
-	if err := formatGoFile(outputFilePath); err != nil {
-		return "", err
+	if err := typeOpts.validateProvider(prismaSchema.Provider()); err != nil {
+		return nil, err
 	}
-
-	return outputFilePath, nil
-}
-
-func extractTableNames(
-	filePath string,
-) (map[string]string, error) {
-	file, err := os.Open(filePath)
+	resolver, err := newEntitiesResolver(prismaSchema, names, cfg, typeOpts)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
-	tables := make(map[string]string) // modelName -> tableName
-
-	modelRegex := regexp.MustCompile(`^model\s+(\w+)`)
-	mapRegex := regexp.MustCompile(`@@map\("([^"]+)"\)`)
-
-	var currentModel string
-	inModelBlock := false
+	packages, err := schemaPackages(prismaSchema, outDir, opts.PackagePerSchema)
+	if err != nil {
+		return nil, err
+	}
 
-	scanner := bufio.NewScanner(file)
+	outputFiles := make([]string, 0, len(packages))
+	for _, pkg := range packages {
+		outputFilePath := filepath.Join(pkg.dir, "table_gen.go")
+
+		// Extract table names and columns
+		tables := extractTableNames(pkg.schema, names)
+		pkgResolver := resolver.withPackage(pkg, packages)
+		columns := extractColumns(
+			pkg.schema,
+			names,
+			pkgResolver,
+			typeOpts.Nullable,
+		)
+		views := extractViewNames(pkg.schema, names)
+		schemas := extractSchemaNames(pkg.schema, names)
+		keys := extractKeys(pkg.schema, names)
+
+		// Generate the Go file content
+		goFileContent := generateGoFileContent(
+			pkg.name,
+			prismaSchema.Provider(),
+			tables,
+			columns,
+			views,
+			schemas,
+			keys,
+			pkgResolver.packages,
+		)
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
+		// Write the content to the output Go file
+		if err := writeToFile(pkg.dir, outputFilePath, goFileContent); err != nil {
+			return nil, err
+		}
 
-		// Detect new model
-		if matches := modelRegex.FindStringSubmatch(line); len(matches) > 1 {
-			currentModel = matches[1]
-			inModelBlock = true
-			tables[currentModel] = strings.ToLower(currentModel)
-			continue
+		if err := formatGoFile(outputFilePath); err != nil {
+			return nil, err
 		}
 
-		// Parse model fields for column names and types
-		if inModelBlock {
-			// Parse @@map for table name
-			if matches := mapRegex.FindStringSubmatch(line); len(matches) > 1 {
-				tables[currentModel] = matches[1]
-			}
+		outputFiles = append(outputFiles, outputFilePath)
+	}
+
+	return outputFiles, nil
+}
 
-			// Detect end of model block
-			if strings.HasPrefix(line, "}") {
-				inModelBlock = false
-				currentModel = ""
+// checkTableNames reports schema elements whose generated Go identifiers
+// collide in the tables package.
+func checkTableNames(prismaSchema *schema.Schema, names goNames) schema.Diagnostics {
+	var diags schema.Diagnostics
+
+	resolver := newTypeResolver(prismaSchema)
+	pkg := newGoScope("the tables package", &diags)
+	pkg.reserve("built-in tables helper", tablesHelpers...)
+	for _, model := range slices.Concat(prismaSchema.Models, prismaSchema.Views) {
+		source := fmt.Sprintf("%s %s", model.Kind, model.Name)
+		modelName := names.model(model)
+		pkg.declare(modelName, source, model.Pos)
+		pkg.declare("table"+modelName, source, model.Pos)
+
+		methods := newGoScope("table"+modelName, &diags)
+		methods.reserve("built-in table helper", "String", "All", "Unquoted")
+		methods.reserve("generated key methods", "PrimaryKey", "UniqueKeys", "Indexes")
+		for _, field := range model.Fields {
+			if !resolver.isColumn(field) || goOmitted(field) {
+				continue
 			}
+			methods.declare(
+				names.field(field),
+				fmt.Sprintf("field %s.%s", model.Name, field.Name),
+				field.Pos,
+			)
 		}
 	}
 
-	return tables, nil
+	return diags
 }
 
-func extractColumnNames(
-	filePath string,
-	tables map[string]string,
-) (map[string]map[string]string, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, err
+func extractTableNames(
+	prismaSchema *schema.Schema,
+	names goNames,
+) map[string]string {
+	tables := make(map[string]string) // Go model name -> tableName
+
+	// Use @@map for table name if present, otherwise the model name, as Prisma
+	for _, model := range slices.Concat(prismaSchema.Models, prismaSchema.Views) {
+		tables[names.model(model)] = model.DBName()
 	}
-	defer file.Close()
-
-	columns := map[string]map[string]string{} // modelName -> {tableName.columnName: columnType}
 
-	modelRegex := regexp.MustCompile(`^model\s+(\w+)`)
-	fieldRegex := regexp.MustCompile(
-		`^(\w+)\s+(\w+)`,
-	) // Matches columnName columnType
+	return tables
+}
 
-	var currentModel string
-	inModelBlock := false
+func extractColumns(
+	prismaSchema *schema.Schema,
+	names goNames,
+	resolver typeResolver,
+	nullable NullableMode,
+) map[string]map[string]tableColumn {
+	columns := map[string]map[string]tableColumn{} // Go model name -> {Go field name: column}
+
+	for _, model := range slices.Concat(prismaSchema.Models, prismaSchema.Views) {
+		modelName := names.model(model)
+		columns[modelName] = map[string]tableColumn{}
+
+		for _, field := range model.Fields {
+			// Only add scalar and enum columns, including lists of them
+			fieldType, ok := resolver.goType(field)
+			if !ok || goOmitted(field) {
+				continue
+			}
+			// Use @map for column name if present, otherwise the field name
+			columns[modelName][names.field(field)] = tableColumn{
+				name:      field.DBName(),
+				goType:    structFieldType(field, fieldType, nullable),
+				valueType: columnValueType(field, resolver),
+				nullable:  field.Type.Optional,
+			}
+		}
+	}
 
-	scanner := bufio.NewScanner(file)
+	return columns
+}
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
+// extractViewNames returns the models of the schema that are views, whose
+// helpers are documented as read-only.
+func extractViewNames(prismaSchema *schema.Schema, names goNames) map[string]bool {
+	views := map[string]bool{}
+	for _, view := range prismaSchema.Views {
+		views[names.model(view)] = true
+	}
+	return views
+}
 
-		// Detect new model
-		if matches := modelRegex.FindStringSubmatch(line); len(matches) > 1 {
-			currentModel = matches[1]
-			inModelBlock = true
-			columns[currentModel] = map[string]string{} // Initialize column map
-			continue
+// extractSchemaNames returns the database schema (@@schema) of the models
+// and views that have one, which qualifies their table names.
+func extractSchemaNames(prismaSchema *schema.Schema, names goNames) map[string]string {
+	schemas := map[string]string{}
+	for _, model := range slices.Concat(prismaSchema.Models, prismaSchema.Views) {
+		if schemaName := model.SchemaName(); schemaName != "" {
+			schemas[names.model(model)] = schemaName
 		}
+	}
+	return schemas
+}
 
-		// Parse model fields for column names and types
-		if inModelBlock {
-			if matches := fieldRegex.FindStringSubmatch(line); len(
-				matches,
-			) > 2 {
-				columnName := matches[1]
-				columnType := matches[2]
-				table := tables[currentModel]
-
-				// Only add to columns if the column type is in typeMap
-				if _, exists := typeMap[columnType]; exists {
-					columns[currentModel][table+"."+columnName] = columnType
-				}
-			}
+// tableKeys holds the columns of the primary key, the unique constraints and
+// the indexes of a table.
+type tableKeys struct {
+	primary []string
+	unique  [][]string
+	indexes [][]string
+}
 
-			// Detect end of model block
-			if strings.HasPrefix(line, "}") {
-				inModelBlock = false
-				currentModel = ""
-			}
+// extractKeys returns the keys of the models and views.
+func extractKeys(prismaSchema *schema.Schema, names goNames) map[string]tableKeys {
+	keys := map[string]tableKeys{}
+	for _, model := range slices.Concat(prismaSchema.Models, prismaSchema.Views) {
+		var tk tableKeys
+		if key := model.PrimaryKey(); key != nil {
+			tk.primary = key.Columns()
+		}
+		for _, key := range model.UniqueKeys() {
+			tk.unique = append(tk.unique, key.Columns())
+		}
+		for _, key := range model.Indexes() {
+			tk.indexes = append(tk.indexes, key.Columns())
 		}
+		keys[names.model(model)] = tk
 	}
+	return keys
+}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
+// goColumns returns the Go expression of the qualified columns of a table,
+// or nil when there are none.
+func goColumns(columns []string) string {
+	if len(columns) == 0 {
+		return "nil"
+	}
+	exprs := make([]string, 0, len(columns))
+	for _, column := range columns {
+		exprs = append(exprs, fmt.Sprintf("t.column(%q)", column))
+	}
+	return "[]string{" + strings.Join(exprs, ", ") + "}"
+}
+
+// goColumnLists returns the Go expression of lists of qualified columns,
+// or nil when there are none.
+func goColumnLists(lists [][]string) string {
+	if len(lists) == 0 {
+		return "nil"
+	}
+	exprs := make([]string, 0, len(lists))
+	for _, columns := range lists {
+		exprs = append(exprs, strings.TrimPrefix(goColumns(columns), "[]string"))
+	}
+	return "[][]string{" + strings.Join(exprs, ", ") + "}"
+}
+
+// identifierQuotes returns the opening and closing quotes the datasource
+// provider uses for identifiers. MongoDB has no identifiers to quote.
+func identifierQuotes(provider string) (string, string) {
+	switch provider {
+	case "mysql":
+		return "`", "`"
+	case "sqlserver":
+		return "[", "]"
+	case "mongodb":
+		return "", ""
+	default:
+		// postgresql, cockroachdb and sqlite
+		return `"`, `"`
+	}
+}
+
+// generateQuoteIdent generates the quoteIdent function of the tables
+// package, escaping closing quotes by doubling them.
+func generateQuoteIdent(provider string) string {
+	open, closing := identifierQuotes(provider)
+	if open == "" {
+		return "func quoteIdent(ident string) string {\n\treturn ident\n}\n\n"
 	}
 
-	return columns, nil
+	return fmt.Sprintf(
+		"func quoteIdent(ident string) string {\n"+
+			"\treturn %q + strings.ReplaceAll(ident, %q, %q) + %q\n"+
+			"}\n\n",
+		open,
+		closing,
+		closing+closing,
+		closing,
+	)
 }
 
 // generateGoFileContent generates the content of the Go file
 func generateGoFileContent(
-	packageName string,
+	packageName, provider string,
 	tables map[string]string,
-	columns map[string]map[string]string,
+	columns map[string]map[string]tableColumn,
+	views map[string]bool,
+	schemas map[string]string,
+	keys map[string]tableKeys,
+	packages map[string]string,
 ) string {
 	var builder strings.Builder
 
@@ -170,7 +312,56 @@ func generateGoFileContent(
 		"// Code generated by prisma-go-tools. DO NOT EDIT.\n\n",
 	)
 	builder.WriteString(fmt.Sprintf("package %s\n\n", packageName))
-	builder.WriteString("import \"fmt\"\n\n")
+	var valueTypes []string
+	for _, cols := range columns {
+		for _, col := range cols {
+			valueTypes = append(valueTypes, col.valueType)
+		}
+	}
+	builder.WriteString(tablesImports(provider, valueTypes, packages))
+
+	// Dialect and shared helpers
+	builder.WriteString(
+		"// Dialect is the datasource provider identifiers are quoted for.\n",
+	)
+	builder.WriteString(fmt.Sprintf("const Dialect = %q\n\n", provider))
+	builder.WriteString("// quoteIdent quotes an identifier for the Dialect.\n")
+	builder.WriteString(generateQuoteIdent(provider))
+	builder.WriteString(generatePlaceholder(provider))
+	builder.WriteString(`// table holds the physical name of a table, its database schema if any, and
+// whether its identifiers are rendered quoted.
+type table struct {
+	schema string
+	name   string
+	quoted bool
+}
+
+func (t table) quote(ident string) string {
+	if !t.quoted {
+		return ident
+	}
+	return quoteIdent(ident)
+}
+
+func (t table) column(name string) string {
+	return t.String() + "." + t.quote(name)
+}
+
+// String returns the table name, qualified with its schema if any.
+func (t table) String() string {
+	if t.schema != "" {
+		return t.quote(t.schema) + "." + t.quote(t.name)
+	}
+	return t.quote(t.name)
+}
+
+// All returns the wildcard selecting every column of the table.
+func (t table) All() string {
+	return t.String() + ".*"
+}
+
+`)
+	builder.WriteString(columnHelpers)
 
 	// Iterate through each table and generate its type and methods
 	sortedModelNames := make([]string, 0, len(tables))
@@ -183,48 +374,92 @@ func generateGoFileContent(
 		tableName := tables[modelName]
 
 		// Generate type for each table
-		builder.WriteString(fmt.Sprintf("type table%s string\n\n", modelName))
-
 		builder.WriteString(
-			fmt.Sprintf("func (t table%s) String() string {\n", modelName),
+			fmt.Sprintf("type table%s struct {\n\ttable\n}\n\n", modelName),
 		)
-		builder.WriteString("\treturn string(t)\n")
-		builder.WriteString("}\n\n")
 
 		builder.WriteString(
-			fmt.Sprintf("func (t table%s) All() string {\n", modelName),
+			"// Unquoted returns a copy of the table rendering bare identifiers.\n",
 		)
-		builder.WriteString("\treturn fmt.Sprintf(\"%s.*\", t)\n")
+		builder.WriteString(
+			fmt.Sprintf(
+				"func (t table%[1]s) Unquoted() table%[1]s {\n",
+				modelName,
+			),
+		)
+		builder.WriteString("\tt.quoted = false\n")
+		builder.WriteString("\treturn t\n")
 		builder.WriteString("}\n\n")
 
 		// Generate column methods for each table
 		cols := columns[modelName]
-		fullColNames := slices.Collect(maps.Keys(cols))
-		slices.Sort(fullColNames)
+		methodNames := slices.Collect(maps.Keys(cols))
+		slices.Sort(methodNames)
 
-		for _, fullColName := range fullColNames {
-			colName := strings.Split(fullColName, ".")[1]
-			methodName := strcase.ToGoPascal(colName)
+		for _, methodName := range methodNames {
+			col := cols[methodName]
 
 			// Generate method for each column in the table
 			builder.WriteString(
 				fmt.Sprintf(
-					"func (t table%s) %s() string {\n",
+					"func (t table%s) %s() Column[%s] {\n",
 					modelName,
 					methodName,
+					col.valueType,
 				),
 			)
 			builder.WriteString(
-				fmt.Sprintf("\treturn fmt.Sprintf(\"%%s.%s\", t)\n", colName),
+				fmt.Sprintf(
+					"\treturn Column[%s]{table: t.table, name: %q, goType: %q, nullable: %t}\n",
+					col.valueType,
+					col.name,
+					col.goType,
+					col.nullable,
+				),
 			)
 			builder.WriteString("}\n\n")
 		}
 
+		tk := keys[modelName]
+		builder.WriteString(fmt.Sprintf(
+			"// PrimaryKey returns the columns of the primary key of the table.\n"+
+				"func (t table%s) PrimaryKey() []string {\n\treturn %s\n}\n\n",
+			modelName,
+			goColumns(tk.primary),
+		))
+		builder.WriteString(fmt.Sprintf(
+			"// UniqueKeys returns the columns of every unique constraint of the\n"+
+				"// table, besides the primary key.\n"+
+				"func (t table%s) UniqueKeys() [][]string {\n\treturn %s\n}\n\n",
+			modelName,
+			goColumnLists(tk.unique),
+		))
+		builder.WriteString(fmt.Sprintf(
+			"// Indexes returns the columns of every index of the table.\n"+
+				"func (t table%s) Indexes() [][]string {\n\treturn %s\n}\n\n",
+			modelName,
+			goColumnLists(tk.indexes),
+		))
+
+		if views[modelName] {
+			builder.WriteString(
+				fmt.Sprintf(
+					"// %s is the read-only view %q, it can only be selected from.\n",
+					modelName,
+					tableName,
+				),
+			)
+		}
+		schemaField := ""
+		if schemaName, ok := schemas[modelName]; ok {
+			schemaField = fmt.Sprintf("schema: %q, ", schemaName)
+		}
 		builder.WriteString(
 			fmt.Sprintf(
-				"const %s = table%s(\"%s\")\n\n",
+				"var %s = table%s{table{%sname: %q, quoted: true}}\n\n",
 				modelName,
 				modelName,
+				schemaField,
 				tableName,
 			),
 		)
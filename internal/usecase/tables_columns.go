@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// tablesHelpers lists the identifiers declared by the helpers of the tables
+// package.
+var tablesHelpers = []string{
+	"Dialect", "quoteIdent", "placeholder", "table", "Column", "Expr", "Raw", "Build",
+	"fragment",
+}
+
+// tableColumn is a column of a table, with the Go type of its entity field
+// and the Go type of the values it is compared with.
+type tableColumn struct {
+	name      string
+	goType    string
+	valueType string
+	nullable  bool
+}
+
+// entityHelperTypes lists the helper types generated in the entities for
+// native types, whose values are stored in their text form.
+var entityHelperTypes = []string{"Date", "TimeOfDay", "Inet"}
+
+// columnValueType returns the Go type of the values a column is compared
+// with. The tables package cannot refer to the types generated in the
+// entities, so ID types are compared as the type they are defined over, and
+// enums and the Date, TimeOfDay and Inet helpers as the strings they are
+// stored as.
+func columnValueType(field *schema.Field, resolver typeResolver) string {
+	fieldType, _ := resolver.withIDTypes(nil).goType(field)
+	base := fieldType.base
+	if resolver.kind(field) == enumField || slices.Contains(entityHelperTypes, base) {
+		base = "string"
+	}
+	if field.Type.List {
+		return "[]" + base
+	}
+	return base
+}
+
+// generatePlaceholder generates the placeholder function of the tables
+// package, numbering the placeholders like the database driver expects.
+func generatePlaceholder(provider string) string {
+	var expr string
+	switch provider {
+	case "postgresql", "cockroachdb":
+		expr = `"$" + strconv.Itoa(n)`
+	case "sqlserver":
+		expr = `"@p" + strconv.Itoa(n)`
+	default:
+		// mysql, sqlite and mongodb
+		expr = `"?"`
+	}
+	return fmt.Sprintf(
+		"// placeholder returns the placeholder of the n-th argument of a query,\n"+
+			"// counted from 1, for the Dialect.\n"+
+			"func placeholder(n int) string {\n\treturn %s\n}\n\n",
+		expr,
+	)
+}
+
+// tablesImports returns the import block of the tables package, with the
+// packages of the Go types of the column values.
+func tablesImports(provider string, valueTypes []string, packages map[string]string) string {
+	imports := goImports{}
+	imports.add("errors")
+	imports.add("fmt")
+	if strings.Contains(generatePlaceholder(provider), "strconv") {
+		imports.add("strconv")
+	}
+	imports.add("strings")
+	for _, valueType := range valueTypes {
+		imports.addType(valueType, packages)
+	}
+	return imports.String()
+}
+
+// columnHelpers are the Column and Expr types of the tables package,
+// rendering SQL fragments for the Dialect.
+const columnHelpers = `// Column is a column of a table, compared with values of type T. It renders
+// qualified with its table, and builds the conditions and clauses of queries
+// using it.
+type Column[T any] struct {
+	table    table
+	name     string
+	goType   string
+	nullable bool
+}
+
+// Name returns the unquoted name of the column.
+func (c Column[T]) Name() string {
+	return c.name
+}
+
+// Table returns the name of the table of the column, qualified with its
+// schema if any.
+func (c Column[T]) Table() string {
+	return c.table.String()
+}
+
+// GoType returns the Go type of the field of the column in the entities,
+// generated with the type options of the configuration file.
+func (c Column[T]) GoType() string {
+	return c.goType
+}
+
+// Nullable reports whether the column is optional.
+func (c Column[T]) Nullable() bool {
+	return c.nullable
+}
+
+// String returns the column qualified with its table.
+func (c Column[T]) String() string {
+	return c.table.column(c.name)
+}
+
+// As returns the column aliased as alias, for select lists.
+func (c Column[T]) As(alias string) string {
+	return c.String() + " AS " + c.table.quote(alias)
+}
+
+// Asc returns the column sorted in ascending order, for ORDER BY clauses.
+func (c Column[T]) Asc() string {
+	return c.String() + " ASC"
+}
+
+// Desc returns the column sorted in descending order, for ORDER BY clauses.
+func (c Column[T]) Desc() string {
+	return c.String() + " DESC"
+}
+
+// Eq returns the condition that the column equals value.
+func (c Column[T]) Eq(value T) Expr {
+	return c.compare("=", value)
+}
+
+// Ne returns the condition that the column differs from value.
+func (c Column[T]) Ne(value T) Expr {
+	return c.compare("<>", value)
+}
+
+// Lt returns the condition that the column is less than value.
+func (c Column[T]) Lt(value T) Expr {
+	return c.compare("<", value)
+}
+
+// Le returns the condition that the column is less than or equal to value.
+func (c Column[T]) Le(value T) Expr {
+	return c.compare("<=", value)
+}
+
+// Gt returns the condition that the column is greater than value.
+func (c Column[T]) Gt(value T) Expr {
+	return c.compare(">", value)
+}
+
+// Ge returns the condition that the column is greater than or equal to
+// value.
+func (c Column[T]) Ge(value T) Expr {
+	return c.compare(">=", value)
+}
+
+// EqColumn returns the condition that the column equals other, e.g. in join
+// conditions.
+func (c Column[T]) EqColumn(other Column[T]) Expr {
+	return fragment(c.String() + " = " + other.String())
+}
+
+func (c Column[T]) compare(operator string, value T) Expr {
+	return Expr{parts: []string{c.String() + " " + operator + " ", ""}, args: []any{value}}
+}
+
+// In returns the condition that the column equals one of values. Without
+// values, the condition is always false.
+func (c Column[T]) In(values ...T) Expr {
+	if len(values) == 0 {
+		return fragment("1 = 0")
+	}
+	parts := make([]string, 0, len(values)+1)
+	parts = append(parts, c.String()+" IN (")
+	args := make([]any, 0, len(values))
+	for i, value := range values {
+		if i > 0 {
+			parts = append(parts, ", ")
+		}
+		args = append(args, value)
+	}
+	parts = append(parts, ")")
+	return Expr{parts: parts, args: args}
+}
+
+// IsNull returns the condition that the column is NULL.
+func (c Column[T]) IsNull() Expr {
+	return fragment(c.String() + " IS NULL")
+}
+
+// IsNotNull returns the condition that the column is not NULL.
+func (c Column[T]) IsNotNull() Expr {
+	return fragment(c.String() + " IS NOT NULL")
+}
+
+// Expr is a SQL fragment with the arguments of its placeholders. The
+// placeholders are numbered for the Dialect when the query is built, so
+// fragments can be combined in any order.
+type Expr struct {
+	// parts holds the SQL around the placeholders, one more than args.
+	parts []string
+	args  []any
+	err   error
+}
+
+// fragment returns a SQL fragment without placeholders.
+func fragment(sql string) Expr {
+	return Expr{parts: []string{sql}}
+}
+
+// Raw returns a raw SQL fragment, whose ? are the placeholders of args.
+// Write ?? for a literal ?, e.g. in string literals or in the jsonb
+// operators of PostgreSQL. When the number of placeholders and arguments
+// differ, the fragment holds an error, reported by Err and Build.
+func Raw(sql string, args ...any) Expr {
+	var parts []string
+	var part strings.Builder
+	for i := 0; i < len(sql); i++ {
+		switch {
+		case sql[i] != '?':
+			part.WriteByte(sql[i])
+		case i+1 < len(sql) && sql[i+1] == '?':
+			part.WriteByte('?')
+			i++
+		default:
+			parts = append(parts, part.String())
+			part.Reset()
+		}
+	}
+	parts = append(parts, part.String())
+	if len(parts) != len(args)+1 {
+		return Expr{err: fmt.Errorf("%d placeholders for %d arguments in %q", len(parts)-1, len(args), sql)}
+	}
+	return Expr{parts: parts, args: args}
+}
+
+// And returns the conjunction of e and other.
+func (e Expr) And(other Expr) Expr {
+	return e.join(" AND ", other)
+}
+
+// Or returns the disjunction of e and other.
+func (e Expr) Or(other Expr) Expr {
+	return e.join(" OR ", other)
+}
+
+func (e Expr) join(operator string, other Expr) Expr {
+	if err := errors.Join(e.err, other.err); err != nil {
+		return Expr{err: err}
+	}
+	if len(e.parts) == 0 {
+		return other
+	}
+	if len(other.parts) == 0 {
+		return e
+	}
+	parts := make([]string, 0, len(e.parts)+len(other.parts))
+	parts = append(parts, "("+e.parts[0])
+	parts = append(parts, e.parts[1:]...)
+	parts[len(parts)-1] += ")" + operator + "(" + other.parts[0]
+	parts = append(parts, other.parts[1:]...)
+	parts[len(parts)-1] += ")"
+	return Expr{parts: parts, args: append(append([]any{}, e.args...), other.args...)}
+}
+
+// Args returns the arguments of the placeholders of the fragment.
+func (e Expr) Args() []any {
+	return e.args
+}
+
+// Err returns the error of an invalid fragment built with Raw.
+func (e Expr) Err() error {
+	return e.err
+}
+
+// String returns the fragment with its placeholders numbered from 1.
+func (e Expr) String() string {
+	return e.render(0)
+}
+
+func (e Expr) render(offset int) string {
+	var sql strings.Builder
+	for i, part := range e.parts {
+		if i > 0 {
+			sql.WriteString(placeholder(offset + i))
+		}
+		sql.WriteString(part)
+	}
+	return sql.String()
+}
+
+// Build joins the parts of a query with spaces and returns it with its
+// arguments. Parts are raw SQL strings, Expr fragments, whose placeholders
+// are numbered in order, or values formatted with fmt, like tables and
+// columns. It fails with the errors of the invalid fragments.
+func Build(parts ...any) (string, []any, error) {
+	var sql strings.Builder
+	var args []any
+	var errs []error
+	for i, part := range parts {
+		if i > 0 {
+			sql.WriteByte(' ')
+		}
+		switch p := part.(type) {
+		case Expr:
+			if p.err != nil {
+				errs = append(errs, p.err)
+				continue
+			}
+			sql.WriteString(p.render(len(args)))
+			args = append(args, p.args...)
+		case string:
+			sql.WriteString(p)
+		default:
+			fmt.Fprint(&sql, p)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return "", nil, err
+	}
+	return sql.String(), args, nil
+}
+
+`
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"cmp"
+	"testing"
+)
+
+func TestPrismaToSQLTables(t *testing.T) {
+	tests := []struct {
+		name string
+		// out is the output directory, and golden directory, of the test
+		// case, tables by default. Variants of the options get their own.
+		out  string
+		opts TablesOptions
+	}{
+		{name: "schema_folder"},
+		{name: "column_names"},
+		{name: "type_overrides"},
+		{name: "enum_list_columns"},
+		{name: "enum_values"},
+		{name: "tables_types"},
+		{name: "dialect_postgresql"},
+		{name: "dialect_postgres"},
+		{name: "dialect_cockroachdb"},
+		{name: "dialect_mysql"},
+		{name: "dialect_sqlite"},
+		{name: "dialect_sqlserver"},
+		{name: "quoted_identifiers_mysql"},
+		{name: "quoted_identifiers_sqlserver"},
+		{name: "mongodb"},
+		{name: "views"},
+		{name: "ignore"},
+		{name: "ignore", out: "tables_include_ignored", opts: TablesOptions{IncludeIgnored: true}},
+		{name: "multi_schema"},
+		{name: "multi_schema", out: "tables_per_schema", opts: TablesOptions{PackagePerSchema: true}},
+		{name: "annotations"},
+		{name: "naming"},
+		{name: "keys"},
+		{name: "id_types"},
+	}
+
+	for _, tt := range tests {
+		out := cmp.Or(tt.out, "tables")
+		t.Run(tt.name+"/"+out, func(t *testing.T) {
+			testGolden(t, tt.name, out, func(schemaPath, configPath, outDir string) ([]string, error) {
+				opts := tt.opts
+				opts.ConfigPath = configPath
+				return PrismaToSQLTables(schemaPath, outDir, opts)
+			})
+		})
+	}
+}
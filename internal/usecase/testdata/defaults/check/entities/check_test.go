@@ -0,0 +1,46 @@
+package check
+
+import (
+	"regexp"
+	"testing"
+
+	"example.com/app/entities"
+	"github.com/google/uuid"
+)
+
+func TestNewAccount(t *testing.T) {
+	a, b := entities.NewAccount(), entities.NewAccount()
+	if a.ID == b.ID || a.Slug == b.Slug || a.Token == b.Token || a.SortKey == b.SortKey {
+		t.Error("NewAccount() generates the same identifiers twice")
+	}
+
+	if version := uuid.MustParse(a.PublicID).Version(); version != 7 {
+		t.Errorf("PublicID is a version %d UUID, want 7", version)
+	}
+	if a.ID.Version() != 4 {
+		t.Errorf("ID is a version %d UUID, want 4", a.ID.Version())
+	}
+
+	formats := []struct {
+		name, value string
+		format      *regexp.Regexp
+	}{
+		{"Slug", a.Slug, regexp.MustCompile(`^c[0-9a-z]{24}$`)},
+		{"Slug2", a.Slug2, regexp.MustCompile(`^[a-z][0-9a-z]{23}$`)},
+		{"Token", a.Token, regexp.MustCompile(`^[A-Za-z0-9_-]{21}$`)},
+		{"ShortCode", a.ShortCode, regexp.MustCompile(`^[A-Za-z0-9_-]{10}$`)},
+		{"SortKey", a.SortKey, regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Z]{25}$`)},
+	}
+	for _, f := range formats {
+		if !f.format.MatchString(f.value) {
+			t.Errorf("%s = %q, want a match of %s", f.name, f.value, f.format)
+		}
+	}
+
+	if *a.Nickname != "friend" || *a.Seats != 1 {
+		t.Errorf("Nickname, Seats = %q, %d, want friend, 1", *a.Nickname, *a.Seats)
+	}
+	if a.CreatedAt.IsZero() || a.SearchKey != "" {
+		t.Errorf("CreatedAt, SearchKey = %v, %q, want now and unset", a.CreatedAt, a.SearchKey)
+	}
+}
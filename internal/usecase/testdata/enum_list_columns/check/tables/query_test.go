@@ -0,0 +1,25 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	"example.com/app/tables"
+)
+
+func TestEnumAndListColumns(t *testing.T) {
+	query, args, err := tables.Build(
+		"SELECT", tables.User.ID(), "FROM", tables.User,
+		"WHERE", tables.User.Role().In("USER", "ADMIN").And(tables.User.Tags().Eq([]string{"go"})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `SELECT "User"."id" FROM "User" WHERE ("User"."role" IN ($1, $2)) AND ("User"."tags" = $3)`
+	if query != want {
+		t.Errorf("query = %s, want %s", query, want)
+	}
+	if wantArgs := []any{"USER", "ADMIN", []string{"go"}}; !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args = %v, want %v", args, wantArgs)
+	}
+}
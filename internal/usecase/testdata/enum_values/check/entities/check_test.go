@@ -0,0 +1,25 @@
+package check
+
+import (
+	"testing"
+
+	"example.com/app/entities"
+)
+
+func TestMappedValues(t *testing.T) {
+	if got := entities.TaskStatusInProgress.String(); got != "in_progress" {
+		t.Errorf("TaskStatusInProgress = %s, want in_progress", got)
+	}
+	if got := entities.TaskStatusInProgress.TypeName(); got != "task_status" {
+		t.Errorf("TypeName() = %s, want task_status", got)
+	}
+	if status, err := entities.ParseTaskStatus("done"); err != nil || status != entities.TaskStatusDone {
+		t.Errorf("ParseTaskStatus(done) = %q, %v", status, err)
+	}
+	if _, err := entities.ParseTaskStatus("DONE"); err == nil {
+		t.Error("ParseTaskStatus(DONE) succeeded, want an error")
+	}
+	if got := entities.NewTask().Status; got != entities.TaskStatusInProgress {
+		t.Errorf("NewTask().Status = %s, want in_progress", got)
+	}
+}
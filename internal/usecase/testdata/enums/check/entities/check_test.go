@@ -0,0 +1,65 @@
+package check
+
+import (
+	"encoding/json"
+	"testing"
+
+	"example.com/app/entities"
+)
+
+func TestParseRole(t *testing.T) {
+	if role, err := entities.ParseRole("ADMIN"); err != nil || role != entities.RoleAdmin {
+		t.Errorf("ParseRole(ADMIN) = %q, %v, want ADMIN", role, err)
+	}
+	if _, err := entities.ParseRole("admin"); err == nil {
+		t.Error("ParseRole(admin) succeeded, want an error")
+	}
+}
+
+func TestRoleScan(t *testing.T) {
+	var role entities.Role
+	if err := role.Scan([]byte("USER")); err != nil || role != entities.RoleUser {
+		t.Errorf("Scan(USER) = %v, role %q", err, role)
+	}
+	for _, value := range []any{"GUEST", nil, 1} {
+		if err := role.Scan(value); err == nil {
+			t.Errorf("Scan(%v) succeeded, want an error", value)
+		}
+	}
+	if _, err := entities.Role("GUEST").Value(); err == nil {
+		t.Error("Value() of GUEST succeeded, want an error")
+	}
+}
+
+func TestNullRole(t *testing.T) {
+	var role entities.NullRole
+	if err := role.Scan(nil); err != nil || role.Valid {
+		t.Errorf("Scan(nil) = %v, %+v, want an invalid NullRole", err, role)
+	}
+	if v, err := role.Value(); err != nil || v != nil {
+		t.Errorf("Value() = %v, %v, want nil", v, err)
+	}
+	if err := role.Scan("ADMIN"); err != nil || !role.Valid || role.Role != entities.RoleAdmin {
+		t.Errorf("Scan(ADMIN) = %v, %+v", err, role)
+	}
+}
+
+func TestRoleJSON(t *testing.T) {
+	var user struct {
+		Role     entities.Role
+		Previous entities.NullRole
+	}
+	if err := json.Unmarshal([]byte(`{"Role":"ADMIN","Previous":null}`), &user); err != nil {
+		t.Fatal(err)
+	}
+	if user.Role != entities.RoleAdmin || user.Previous.Valid {
+		t.Errorf("Unmarshal() = %+v", user)
+	}
+	if err := json.Unmarshal([]byte(`{"Role":"GUEST"}`), &user); err == nil {
+		t.Error("Unmarshal() of GUEST succeeded, want an error")
+	}
+	data, err := json.Marshal(user)
+	if err != nil || string(data) != `{"Role":"ADMIN","Previous":null}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+}
@@ -0,0 +1,42 @@
+package check
+
+import (
+	"encoding/json"
+	"testing"
+
+	"example.com/app/entities"
+	"github.com/google/uuid"
+)
+
+func TestUserID(t *testing.T) {
+	raw := uuid.New()
+	id := entities.UserID(raw)
+
+	value, err := id.Value()
+	if err != nil || value != raw.String() {
+		t.Errorf("Value() = %v, %v, want %s", value, err, raw)
+	}
+	var scanned entities.UserID
+	if err := scanned.Scan(raw.String()); err != nil || scanned != id {
+		t.Errorf("Scan(%s) = %v, ID %s", raw, err, uuid.UUID(scanned))
+	}
+
+	data, err := json.Marshal(entities.Post{ID: 1, AuthorID: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var post entities.Post
+	if err := json.Unmarshal(data, &post); err != nil || post.AuthorID != id {
+		t.Errorf("JSON round trip of %s = %v, AuthorID %s", data, err, uuid.UUID(post.AuthorID))
+	}
+}
+
+func TestPostID(t *testing.T) {
+	var id entities.PostID
+	if err := id.Scan(int64(42)); err != nil || id != 42 {
+		t.Errorf("Scan(42) = %v, ID %d", err, id)
+	}
+	if value, err := id.Value(); err != nil || value != int64(42) {
+		t.Errorf("Value() = %v, %v, want 42", value, err)
+	}
+}
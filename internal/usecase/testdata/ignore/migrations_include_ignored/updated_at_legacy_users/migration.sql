@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "legacy_users" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "legacy_users_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "legacy_users_updated_at_trigger"
+BEFORE UPDATE ON "legacy_users"
+FOR EACH ROW
+EXECUTE PROCEDURE "legacy_users_updated_at_trigger"();
@@ -0,0 +1,36 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	"example.com/app/entities"
+)
+
+// keyed is the interface generic repository code uses to build the WHERE
+// clauses of the rows of a table.
+type keyed interface {
+	TableName() string
+	PrimaryKey() []string
+	PrimaryKeyValues() []any
+}
+
+func TestPrimaryKeyValues(t *testing.T) {
+	tests := []struct {
+		row     keyed
+		columns []string
+		values  []any
+	}{
+		{entities.User{ID: 7}, []string{"id"}, []any{7}},
+		{entities.Membership{UserID: 7, TeamID: 3}, []string{"user_id", "team_id"}, []any{7, 3}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.row.PrimaryKey(); !reflect.DeepEqual(got, tt.columns) {
+			t.Errorf("%s PrimaryKey() = %v, want %v", tt.row.TableName(), got, tt.columns)
+		}
+		if got := tt.row.PrimaryKeyValues(); !reflect.DeepEqual(got, tt.values) {
+			t.Errorf("%s PrimaryKeyValues() = %v, want %v", tt.row.TableName(), got, tt.values)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	"example.com/app/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestUserBSON(t *testing.T) {
+	city := "Lisbon"
+	user := entities.User{
+		ID:      primitive.NewObjectID(),
+		Email:   "ada@example.com",
+		Address: entities.Address{Street: "Rua Augusta", City: city},
+		Phones:  []entities.Phone{{Number: "+351 123"}},
+		TagIds:  []primitive.ObjectID{primitive.NewObjectID()},
+	}
+	data, err := bson.Marshal(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"billing", "managerId"} {
+		if _, ok := doc[key]; ok {
+			t.Errorf("optional field %s is marshaled when nil", key)
+		}
+	}
+	if _, ok := doc["_id"].(primitive.ObjectID); !ok {
+		t.Errorf("_id = %T, want primitive.ObjectID", doc["_id"])
+	}
+
+	var got entities.User
+	if err := bson.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, user) {
+		t.Errorf("round trip = %+v, want %+v", got, user)
+	}
+}
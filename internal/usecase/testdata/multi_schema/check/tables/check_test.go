@@ -0,0 +1,29 @@
+package check
+
+import (
+	"testing"
+
+	"example.com/app/tables"
+)
+
+func TestSchemaQualifiedNames(t *testing.T) {
+	sql, args, err := tables.Build(
+		"SELECT", tables.Invoice.All(),
+		"FROM", tables.Invoice,
+		"JOIN", tables.Customer, "ON", tables.Invoice.CustomerID().EqColumn(tables.Customer.ID()),
+		"WHERE", tables.Invoice.Status().Eq("OPEN"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `SELECT "billing"."invoices".* FROM "billing"."invoices" JOIN "sales"."customers" ON "billing"."invoices"."customer_id" = "sales"."customers"."id" WHERE "billing"."invoices"."status" = $1`
+	if sql != want {
+		t.Errorf("Build() = %s, want %s", sql, want)
+	}
+	if len(args) != 1 || args[0] != "OPEN" {
+		t.Errorf("Build() args = %v, want [OPEN]", args)
+	}
+	if got, want := tables.Invoice.Unquoted().String(), "billing.invoices"; got != want {
+		t.Errorf("Unquoted() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,52 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"example.com/app/entities"
+)
+
+func TestDate(t *testing.T) {
+	var d entities.Date
+	if err := d.Scan(time.Date(2024, time.February, 29, 13, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := d.Value(); err != nil || v != "2024-02-29" {
+		t.Errorf("Value() = %v, %v, want 2024-02-29", v, err)
+	}
+	if err := d.Scan("2024-03-01T00:00:00Z"); err != nil || d.String() != "2024-03-01" {
+		t.Errorf("Scan() = %v, date %s, want 2024-03-01", err, d)
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	var tod entities.TimeOfDay
+	if err := tod.Scan([]byte("08:30:15.5")); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := tod.Value(); err != nil || v != "08:30:15.5" {
+		t.Errorf("Value() = %v, %v, want 08:30:15.5", v, err)
+	}
+}
+
+func TestInet(t *testing.T) {
+	tests := map[string]string{
+		"192.168.0.1":    "192.168.0.1/32",
+		"10.0.0.0/8":     "10.0.0.0/8",
+		"::1":            "::1/128",
+		"192.168.0.1/24": "192.168.0.1/24",
+	}
+	for src, want := range tests {
+		var inet entities.Inet
+		if err := inet.Scan(src); err != nil {
+			t.Fatalf("Scan(%s) error = %v", src, err)
+		}
+		if v, err := inet.Value(); err != nil || v != want {
+			t.Errorf("Value() of %s = %v, %v, want %s", src, v, err, want)
+		}
+	}
+	if _, err := (entities.Inet{}).Value(); err == nil {
+		t.Error("Value() of the zero Inet succeeded, want an error")
+	}
+}
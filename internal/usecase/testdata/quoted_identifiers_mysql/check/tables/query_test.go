@@ -0,0 +1,23 @@
+package check
+
+import (
+	"testing"
+
+	"example.com/app/tables"
+)
+
+func TestQuoting(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{tables.Account.String(), "`user accounts`"},
+		{tables.Account.Label().String(), "`user accounts`.`the ``label```"},
+		{tables.Account.Note().String(), "`user accounts`.`[note]`"},
+		{tables.Account.Unquoted().Note().String(), "user accounts.[note]"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %s, want %s", tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Package check uses the generated tables, so that the build check of the
+// test case covers the types of the column values.
+package check
+
+import (
+	"example.com/app/tables"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func PostsOfAdmins(minBalance decimal.Decimal, ids ...uuid.UUID) (string, []any, error) {
+	return tables.Build(
+		"SELECT", tables.Post.All(), "FROM", tables.Post,
+		"JOIN", tables.User, "ON", tables.Post.AuthorID().EqColumn(tables.User.ID()),
+		"WHERE", tables.User.Role().Eq("ADMIN").
+			And(tables.User.Balance().Ge(minBalance)).
+			And(tables.User.Birthday().Lt("2000-01-01")).
+			And(tables.User.ID().In(ids...)),
+	)
+}
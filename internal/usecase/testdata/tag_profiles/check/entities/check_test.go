@@ -0,0 +1,36 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	"example.com/app/entities"
+)
+
+// TestTagsParse checks that every tag of the profiles is well formed, so
+// the libraries reading them with reflect.StructTag.Lookup find them.
+func TestTagsParse(t *testing.T) {
+	keys := []string{"db", "json", "bun", "gorm", "bson"}
+	for _, typ := range []reflect.Type{
+		reflect.TypeFor[entities.User](),
+		reflect.TypeFor[entities.Membership](),
+	} {
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+			for _, key := range keys {
+				if _, ok := field.Tag.Lookup(key); !ok {
+					t.Errorf("%s.%s has no %s tag in `%s`", typ.Name(), field.Name, key, field.Tag)
+				}
+			}
+		}
+	}
+
+	role, _ := reflect.TypeFor[entities.User]().FieldByName("Role")
+	if got, want := role.Tag.Get("validate"), "oneof=USER ADMIN"; got != want {
+		t.Errorf("validate tag of User.Role = %q, want %q", got, want)
+	}
+	id, _ := reflect.TypeFor[entities.Membership]().FieldByName("UserID")
+	if got, want := id.Tag.Get("gorm"), "column:user_id;primaryKey;not null"; got != want {
+		t.Errorf("gorm tag of Membership.UserID = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,8 @@
+// Package orders holds the Go type the metadata of orders is overridden
+// with.
+package orders
+
+// Metadata is the metadata of an order.
+type Metadata struct {
+	Source string `json:"source"`
+}
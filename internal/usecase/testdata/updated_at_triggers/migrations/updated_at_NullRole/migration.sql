@@ -0,0 +1,15 @@
+
+-- Auto-generated trigger for table "NullRole" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "NullRole_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."editedAt" = now();
+    NEW."reviewed_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "NullRole_updated_at_trigger"
+BEFORE UPDATE ON "NullRole"
+FOR EACH ROW
+EXECUTE PROCEDURE "NullRole_updated_at_trigger"();
@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "posts" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "posts_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "posts_updated_at_trigger"
+BEFORE UPDATE ON "posts"
+FOR EACH ROW
+EXECUTE PROCEDURE "posts_updated_at_trigger"();
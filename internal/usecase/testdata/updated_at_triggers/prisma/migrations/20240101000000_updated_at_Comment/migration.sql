@@ -0,0 +1 @@
+-- Comment already has its trigger
@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "billing"."invoices" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "billing"."billing_invoices_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "billing_invoices_updated_at_trigger"
+BEFORE UPDATE ON "billing"."invoices"
+FOR EACH ROW
+EXECUTE PROCEDURE "billing"."billing_invoices_updated_at_trigger"();
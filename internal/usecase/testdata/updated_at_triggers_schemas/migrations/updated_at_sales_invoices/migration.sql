@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "sales"."invoices" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "sales"."sales_invoices_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "sales_invoices_updated_at_trigger"
+BEFORE UPDATE ON "sales"."invoices"
+FOR EACH ROW
+EXECUTE PROCEDURE "sales"."sales_invoices_updated_at_trigger"();
@@ -0,0 +1 @@
+-- accounts already has its trigger
@@ -0,0 +1 @@
+-- billing.payments already has its trigger
@@ -0,0 +1,390 @@
+package usecase
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/config"
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// scalarTypes maps Prisma scalar types to Go types. It is never modified,
+// types declared by a schema and configured overrides are resolved by
+// typeResolver.
+var scalarTypes = map[string]string{
+	"BigInt":      "int64",
+	"Boolean":     "bool",
+	"Bytes":       "[]byte",
+	"DateTime":    "time.Time",
+	"Decimal":     "float64",
+	"Float":       "float64",
+	"Int":         "int",
+	"String":      "string",
+	"Json":        "string",
+	"Unsupported": "any",
+}
+
+// knownPackages maps the package qualifiers used by the built-in type
+// mappings to their import paths.
+var knownPackages = map[string]string{
+	"decimal":   "github.com/shopspring/decimal",
+	"driver":    "database/sql/driver",
+	"json":      "encoding/json",
+	"netip":     "net/netip",
+	"pgtype":    "github.com/jackc/pgx/v5/pgtype",
+	"primitive": "go.mongodb.org/mongo-driver/bson/primitive",
+	"sql":       "database/sql",
+	"time":      "time",
+	"uuid":      "github.com/google/uuid",
+}
+
+var qualifierRegex = regexp.MustCompile(`\b([A-Za-z_]\w*)\.`)
+
+// sqlNullTypes maps Go types to their database/sql Null types.
+var sqlNullTypes = map[string]string{
+	"bool":            "sql.NullBool",
+	"byte":            "sql.NullByte",
+	"decimal.Decimal": "decimal.NullDecimal",
+	"float64":         "sql.NullFloat64",
+	"int16":           "sql.NullInt16",
+	"int32":           "sql.NullInt32",
+	"int64":           "sql.NullInt64",
+	"string":          "sql.NullString",
+	"time.Time":       "sql.NullTime",
+}
+
+// pgtypeNullTypes maps Go types to their pgx pgtype types.
+var pgtypeNullTypes = map[string]string{
+	"bool":      "pgtype.Bool",
+	"float32":   "pgtype.Float4",
+	"float64":   "pgtype.Float8",
+	"int":       "pgtype.Int8",
+	"int16":     "pgtype.Int2",
+	"int32":     "pgtype.Int4",
+	"int64":     "pgtype.Int8",
+	"string":    "pgtype.Text",
+	"time.Time": "pgtype.Timestamptz",
+	"uint32":    "pgtype.Uint32",
+	"uuid.UUID": "pgtype.UUID",
+}
+
+// decimalTypes maps the decimal modes to the Go type of Decimal fields.
+var decimalTypes = map[DecimalMode]string{
+	DecimalString:     "string",
+	DecimalShopspring: "decimal.Decimal",
+	DecimalFloat64:    "float64",
+}
+
+// goFieldType is the resolved Go representation of a column field.
+type goFieldType struct {
+	// base is the non-null Go type, e.g. int16 or uuid.UUID.
+	base string
+	// pgtype overrides the pgtype used for the nullable form.
+	pgtype string
+	// sqlNull overrides the database/sql style type used for the nullable
+	// form, e.g. the NullRole companion of enums.
+	sqlNull string
+	// nullable, when configured, is the Go type of optional fields in every
+	// nullable mode.
+	nullable string
+	// underlying is the Go type an ID type is defined over, e.g. uuid.UUID
+	// for UserID.
+	underlying string
+}
+
+// nullableType returns the Go type of an optional field. Types that already
+// have a NULL value, like []byte and any, are only wrapped in pointer mode.
+func nullableType(fieldType goFieldType, mode NullableMode) string {
+	if fieldType.nullable != "" {
+		return fieldType.nullable
+	}
+
+	base := fieldType.base
+	if mode == NullablePointer {
+		return "*" + base
+	}
+
+	if base == "[]byte" || base == "any" {
+		return base
+	}
+
+	switch mode {
+	case NullableSQL:
+		if fieldType.sqlNull != "" {
+			return fieldType.sqlNull
+		}
+		if nullType, ok := sqlNullTypes[base]; ok {
+			return nullType
+		}
+		return "sql.Null[" + base + "]"
+	case NullablePgtype:
+		if fieldType.pgtype != "" {
+			return fieldType.pgtype
+		}
+		if nullType, ok := pgtypeNullTypes[base]; ok {
+			return nullType
+		}
+		return "*" + base
+	case NullableGeneric:
+		return "Null[" + base + "]"
+	}
+
+	return "*" + base
+}
+
+// fieldKind classifies fields by what their type refers to.
+type fieldKind int
+
+const (
+	scalarField fieldKind = iota
+	enumField
+	compositeField
+	relationField
+)
+
+// typeResolver resolves field types against a single schema, so the enums
+// and composite types of one schema never leak into another. Native types
+// (@db.*) are mapped according to the datasource provider.
+type typeResolver struct {
+	schema      *schema.Schema
+	nativeTypes map[string]map[string]nativeType
+	decimal     DecimalMode
+	overrides   typeOverrides
+	// packages maps the package qualifiers of the resolved types to their
+	// import paths.
+	packages map[string]string
+	// enumPackages maps the enums generated in another package than the
+	// resolved fields to that package.
+	enumPackages map[string]schemaPackage
+	// modelPackages maps the models generated in another package than the
+	// resolved fields to that package, for their ID types.
+	modelPackages map[string]schemaPackage
+	// idTypes maps the fields holding an ID type to the model it belongs to.
+	idTypes idTypes
+	// names are the Go identifiers of the enums and composite types.
+	names goNames
+}
+
+func newTypeResolver(prismaSchema *schema.Schema) typeResolver {
+	return typeResolver{
+		schema:      prismaSchema,
+		nativeTypes: nativeTypesByProvider(prismaSchema.Provider()),
+		decimal:     DecimalString,
+		packages:    knownPackages,
+		names:       goNames{namer: newGoNamer(config.Naming{})},
+	}
+}
+
+// withNames returns a copy of the resolver naming the enums and composite
+// types with names.
+func (r typeResolver) withNames(names goNames) typeResolver {
+	r.names = names
+	return r
+}
+
+// withIDTypes returns a copy of the resolver typing the primary and foreign
+// keys with the ID types of ids.
+func (r typeResolver) withIDTypes(ids idTypes) typeResolver {
+	r.idTypes = ids
+	return r
+}
+
+// withOverrides returns a copy of the resolver applying the configured type
+// overrides.
+func (r typeResolver) withOverrides(overrides typeOverrides) typeResolver {
+	r.overrides = overrides
+	r.packages = overrides.packages
+	return r
+}
+
+// withDecimal returns a copy of the resolver mapping Decimal fields
+// according to mode.
+func (r typeResolver) withDecimal(mode DecimalMode) typeResolver {
+	r.decimal = mode
+	return r
+}
+
+// withPackage returns a copy of the resolver for the fields generated in
+// pkg, qualifying the enums and ID types generated in the other packages.
+func (r typeResolver) withPackage(
+	pkg schemaPackage,
+	packages []schemaPackage,
+) typeResolver {
+	r.enumPackages = map[string]schemaPackage{}
+	r.modelPackages = map[string]schemaPackage{}
+	r.packages = maps.Clone(r.packages)
+	for _, other := range packages {
+		if other.dir == pkg.dir {
+			continue
+		}
+		for _, enum := range other.schema.Enums {
+			r.enumPackages[enum.Name] = other
+		}
+		for _, model := range other.schema.Models {
+			r.modelPackages[model.Name] = other
+		}
+		if other.importPath != "" {
+			r.packages[other.name] = other.importPath
+		}
+	}
+	return r
+}
+
+// packageImport is a field using an enum or ID type generated in another
+// package, which makes the package of the field import that one.
+type packageImport struct {
+	model *schema.Model
+	field *schema.Field
+	// kind and name describe the imported type, e.g. enum Role.
+	kind, name string
+	pkg        schemaPackage
+}
+
+func (i packageImport) String() string {
+	return fmt.Sprintf(
+		"field %s.%s uses %s %s of package %s",
+		i.model.Name,
+		i.field.Name,
+		i.kind,
+		i.name,
+		i.pkg.name,
+	)
+}
+
+// packageImports returns the fields of the models and views of s that use
+// an enum or ID type generated in another package.
+func (r typeResolver) packageImports(s *schema.Schema) []packageImport {
+	var imports []packageImport
+	for _, model := range slices.Concat(s.Models, s.Views) {
+		for _, field := range model.Fields {
+			kind, name := "enum", field.Type.Name
+			other, ok := r.enumPackages[name]
+			if idModel, isID := r.idTypes[field]; isID {
+				kind, name = "ID type", r.names.idType(idModel)
+				other, ok = r.modelPackages[idModel.Name]
+			}
+			if ok {
+				imports = append(imports, packageImport{model, field, kind, name, other})
+			}
+		}
+	}
+	return imports
+}
+
+// checkPackageImports reports the enums and ID types used by the models and
+// views of s that are generated in a package that cannot be imported.
+func (r typeResolver) checkPackageImports(s *schema.Schema) error {
+	for _, imp := range r.packageImports(s) {
+		if imp.pkg.importPath != "" {
+			continue
+		}
+		return fmt.Errorf(
+			"%s: %s, which cannot be imported: no go.mod found above %s",
+			imp.field.Pos,
+			imp,
+			imp.pkg.dir,
+		)
+	}
+	return nil
+}
+
+func (r typeResolver) kind(field *schema.Field) fieldKind {
+	name := field.Type.Name
+	switch {
+	case schema.IsScalar(name):
+		return scalarField
+	case r.schema.Enum(name) != nil:
+		return enumField
+	case r.schema.Type(name) != nil:
+		return compositeField
+	default:
+		return relationField
+	}
+}
+
+// isColumn reports whether the field is stored in a column of its table,
+// i.e. it is a scalar or enum field, including lists of them.
+func (r typeResolver) isColumn(field *schema.Field) bool {
+	kind := r.kind(field)
+	return kind == scalarField || kind == enumField
+}
+
+// goType returns the Go type of a column field, without list or nullable
+// handling. Configured overrides take precedence, from the most specific:
+// the field itself, its native type and its scalar type.
+func (r typeResolver) goType(field *schema.Field) (goFieldType, bool) {
+	if !r.isColumn(field) {
+		return goFieldType{}, false
+	}
+	if fieldType, ok := r.overrides.fields[field]; ok {
+		return fieldType, true
+	}
+	if model, ok := r.idTypes[field]; ok {
+		underlying, _ := r.withIDTypes(nil).goType(model.PrimaryKey().Fields[0])
+		qualifier := ""
+		if other, ok := r.modelPackages[model.Name]; ok {
+			qualifier = other.name + "."
+		}
+		return goFieldType{
+			base:       qualifier + r.names.idType(model),
+			underlying: underlying.base,
+		}, true
+	}
+
+	switch r.kind(field) {
+	case scalarField:
+		return r.scalarGoType(field), true
+	case enumField:
+		qualifier := ""
+		if other, ok := r.enumPackages[field.Type.Name]; ok {
+			qualifier = other.name + "."
+		}
+		name := r.names.enum(r.schema.Enum(field.Type.Name))
+		return goFieldType{
+			base:    qualifier + name,
+			sqlNull: qualifier + "Null" + name,
+		}, true
+	}
+	return goFieldType{}, false
+}
+
+// entityType returns the Go type of a field of a generated struct: the
+// column fields and the fields of composite types, which are embedded as
+// structs and are optional through pointers in every nullable mode.
+func (r typeResolver) entityType(field *schema.Field) (goFieldType, bool) {
+	if goOmitted(field) {
+		return goFieldType{}, false
+	}
+	if r.kind(field) == compositeField {
+		name := r.names.model(r.schema.Type(field.Type.Name))
+		return goFieldType{base: name, nullable: "*" + name}, true
+	}
+	return r.goType(field)
+}
+
+func (r typeResolver) scalarGoType(field *schema.Field) goFieldType {
+	scalar := field.Type.Name
+
+	if native := field.NativeType(); native != nil {
+		name := strings.TrimPrefix(native.Name, "db.")
+		if fieldType, ok := r.overrides.native(scalar, name); ok {
+			return fieldType
+		}
+		if mapped, ok := r.nativeTypes[scalar][name]; ok && scalar != "Decimal" {
+			return goFieldType{base: mapped.goType, pgtype: mapped.pgtype}
+		}
+	}
+
+	if fieldType, ok := r.overrides.scalars[scalar]; ok {
+		return fieldType
+	}
+
+	if scalar == "Decimal" {
+		return goFieldType{base: decimalTypes[r.decimal], pgtype: "pgtype.Numeric"}
+	}
+
+	return goFieldType{base: scalarTypes[scalar]}
+}
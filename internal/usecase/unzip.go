@@ -9,7 +9,7 @@ import (
 func UnZipMigrations(
 	schemaPath string,
 ) error {
-	migrationsDir := filepath.Join(filepath.Dir(schemaPath), "migrations")
+	migrationsDir := schemaMigrationsDir(schemaPath)
 
 	entries, err := os.ReadDir(migrationsDir)
 	if err != nil {
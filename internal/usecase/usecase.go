@@ -1,24 +1,210 @@
 package usecase
 
 import (
+	"errors"
 	"fmt"
+	"go/format"
+	"go/scanner"
 	"io"
+	"maps"
 	"os"
 	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/config"
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
 )
 
-// Maps Prisma types to Go types
-var typeMap = map[string]string{
-	"BigInt":      "int64",
-	"Boolean":     "bool",
-	"Bytes":       "[]byte",
-	"DateTime":    "time.Time",
-	"Decimal":     "float64",
-	"Float":       "float64",
-	"Int":         "int",
-	"String":      "string",
-	"Json":        "string",
-	"Unsupported": "any",
+// loadSchema loads the schema at schemaPath, names its elements in Go and
+// fails with its diagnostics when the schema, or the Go identifiers checked
+// by checks, are not valid. Elements marked with @ignore or @@ignore are left
+// out of the returned schema, and so out of the checks, unless
+// includeIgnored is set.
+func loadSchema(
+	schemaPath string,
+	includeIgnored bool,
+	naming config.Naming,
+	checks ...func(*schema.Schema, goNames) schema.Diagnostics,
+) (*schema.Schema, goNames, error) {
+	prismaSchema, diags, err := validateSchema(schemaPath, includeIgnored)
+	if err != nil {
+		return nil, goNames{}, err
+	}
+
+	names, namingDiags := newGoNames(prismaSchema, newGoNamer(naming))
+	diags = append(diags, namingDiags...)
+	for _, check := range checks {
+		diags = append(diags, check(prismaSchema, names)...)
+	}
+	if diags.HasErrors() {
+		return nil, goNames{}, diags.Errors().Sorted()
+	}
+
+	return prismaSchema, names, nil
+}
+
+// schemaMigrationsDir returns the migrations folder of the schema at
+// schemaPath. Zipping and unzipping migrations only move files, so when the
+// schema does not parse yet the folder is looked up next to the schema path
+// alone.
+func schemaMigrationsDir(schemaPath string) string {
+	prismaSchema, err := schema.Load(schemaPath)
+	if err != nil {
+		prismaSchema = &schema.Schema{Path: schemaPath}
+	}
+	return prismaSchema.MigrationsDir()
+}
+
+// loadSQLSchema loads the schema at schemaPath for the commands generating
+// SQL only, which fail with the diagnostics of the schema but do not check
+// its Go identifiers.
+func loadSQLSchema(schemaPath string, includeIgnored bool) (*schema.Schema, error) {
+	prismaSchema, diags, err := validateSchema(schemaPath, includeIgnored)
+	if err != nil {
+		return nil, err
+	}
+	if diags.HasErrors() {
+		return nil, diags.Errors().Sorted()
+	}
+	return prismaSchema, nil
+}
+
+// validateSchema loads and validates the schema at schemaPath, leaving out
+// the ignored elements unless includeIgnored is set.
+func validateSchema(
+	schemaPath string,
+	includeIgnored bool,
+) (*schema.Schema, schema.Diagnostics, error) {
+	prismaSchema, err := schema.Load(schemaPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diags := schema.Validate(prismaSchema)
+	if !includeIgnored {
+		prismaSchema = prismaSchema.WithoutIgnored()
+	}
+	return prismaSchema, diags, nil
+}
+
+// goScope tracks the Go identifiers generated in a scope, e.g. a package or
+// a struct, and reports the schema elements whose names collide.
+type goScope struct {
+	name  string
+	seen  map[string]goIdentifier
+	diags *schema.Diagnostics
+}
+
+type goIdentifier struct {
+	source string
+	pos    schema.Position
+}
+
+func newGoScope(name string, diags *schema.Diagnostics) *goScope {
+	return &goScope{
+		name:  name,
+		seen:  map[string]goIdentifier{},
+		diags: diags,
+	}
+}
+
+// reserve marks identifiers that are always generated in the scope.
+func (s *goScope) reserve(source string, idents ...string) {
+	for _, ident := range idents {
+		s.seen[ident] = goIdentifier{source: source}
+	}
+}
+
+// declare registers ident, generated from source, and reports a collision
+// when another schema element already generated the same identifier.
+func (s *goScope) declare(ident, source string, pos schema.Position) {
+	first, ok := s.seen[ident]
+	if !ok {
+		s.seen[ident] = goIdentifier{source: source, pos: pos}
+		return
+	}
+
+	// Duplicate declarations are already reported by schema.Validate.
+	if first.source == source {
+		return
+	}
+
+	if !first.pos.IsValid() {
+		s.diags.Errorf(
+			pos,
+			"%s generates Go identifier %s in %s, which collides with the %s",
+			source,
+			ident,
+			s.name,
+			first.source,
+		)
+		return
+	}
+
+	s.diags.Errorf(
+		pos,
+		"%s generates Go identifier %s in %s, which collides with %s (declared at %s)",
+		source,
+		ident,
+		s.name,
+		first.source,
+		first.pos,
+	)
+}
+
+// goImports collects the import paths referenced by generated code, mapped
+// to the alias they are imported as, if any.
+type goImports map[string]string
+
+func (i goImports) add(path string) {
+	if _, ok := i[path]; !ok {
+		i[path] = ""
+	}
+}
+
+// addType registers the packages referenced by a Go type expression, e.g.
+// both database/sql and github.com/google/uuid for sql.Null[uuid.UUID].
+// packages maps the qualifiers to their import paths; qualifiers other than
+// the package name become import aliases.
+func (i goImports) addType(expr string, packages map[string]string) {
+	for _, match := range qualifierRegex.FindAllStringSubmatch(expr, -1) {
+		path, ok := packages[match[1]]
+		if !ok {
+			continue
+		}
+		if packageName(path) == match[1] {
+			i.add(path)
+		} else {
+			i[path] = match[1]
+		}
+	}
+}
+
+// String renders the import block, standard library packages first.
+func (i goImports) String() string {
+	if len(i) == 0 {
+		return ""
+	}
+
+	var std, others []string
+	for _, path := range slices.Sorted(maps.Keys(i)) {
+		spec := fmt.Sprintf("\t%q\n", path)
+		if alias := i[path]; alias != "" {
+			spec = fmt.Sprintf("\t%s %q\n", alias, path)
+		}
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			others = append(others, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+
+	block := "import (\n" + strings.Join(std, "")
+	if len(std) > 0 && len(others) > 0 {
+		block += "\n"
+	}
+	return block + strings.Join(others, "") + ")\n\n"
 }
 
 // writeToFile writes the given content to a file
@@ -30,6 +216,84 @@ func writeToFile(outDir, filePath, content string) error {
 	return os.WriteFile(filePath, []byte(content), 0644)
 }
 
+// goSource builds a generated Go file, remembering the schema element every
+// part of the code was generated from, so syntax errors in the generated
+// code point back to the schema.
+type goSource struct {
+	strings.Builder
+	lines  int
+	chunks []goChunk
+}
+
+// goChunk is the code generated from a schema element, starting at line.
+type goChunk struct {
+	line   int
+	source string
+	pos    schema.Position
+}
+
+// writeChunk appends code generated from the element source declared at
+// pos.
+func (s *goSource) writeChunk(code, source string, pos schema.Position) {
+	s.chunks = append(s.chunks, goChunk{line: s.lines + 1, source: source, pos: pos})
+	s.write(code)
+}
+
+// write appends code that is not generated from a schema element.
+func (s *goSource) write(code string) {
+	s.WriteString(code)
+	s.lines += strings.Count(code, "\n")
+}
+
+// format formats the code, which is written to path after the header. Syntax
+// errors are reported as diagnostics at the schema element that generated
+// the invalid code.
+func (s *goSource) format(path, header string) ([]byte, error) {
+	src := header + s.String()
+	formatted, err := format.Source([]byte(src))
+	if err == nil {
+		return formatted, nil
+	}
+
+	var list scanner.ErrorList
+	if !errors.As(err, &list) {
+		return nil, fmt.Errorf("%s: generated code is not valid Go: %w", path, err)
+	}
+
+	offset := strings.Count(header, "\n")
+	lines := strings.Split(src, "\n")
+	var diags schema.Diagnostics
+	for _, syntaxErr := range list {
+		line := syntaxErr.Pos.Line
+		code := ""
+		if line > 0 && line <= len(lines) {
+			code = strings.TrimSpace(lines[line-1])
+		}
+
+		index := slices.IndexFunc(s.chunks, func(chunk goChunk) bool {
+			return chunk.line > line-offset
+		})
+		if index < 0 {
+			index = len(s.chunks)
+		}
+		if index == 0 {
+			return nil, fmt.Errorf("%s:%d: generated code is not valid Go: %s: %s", path, line, syntaxErr.Msg, code)
+		}
+
+		chunk := s.chunks[index-1]
+		diags.Errorf(
+			chunk.pos,
+			"%s generates invalid Go code at %s:%d: %s: %s",
+			chunk.source,
+			path,
+			line,
+			syntaxErr.Msg,
+			code,
+		)
+	}
+	return nil, diags
+}
+
 // formatGoFile formats the given go file
 func formatGoFile(filePath string) error {
 	command := exec.Command("gofmt", "-w", filePath)
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/danielmesquitta/prisma-go-tools/internal/config"
+	"github.com/danielmesquitta/prisma-go-tools/internal/schema"
+)
+
+// ValidateSchema loads the schema and returns every diagnostic found by the
+// schema checks and by the Go code generators. Syntax errors are returned as
+// diagnostics too, other errors (e.g. missing files) are returned as is.
+// Like the generators, the Go identifiers of ignored elements are not
+// checked. The naming options are read from the configuration file at
+// configPath, or looked up in the working directory when empty.
+func ValidateSchema(schemaPath, configPath string) (schema.Diagnostics, error) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	prismaSchema, err := schema.Load(schemaPath)
+	if err != nil {
+		var diag *schema.Diagnostic
+		if errors.As(err, &diag) {
+			return schema.Diagnostics{diag}, nil
+		}
+		return nil, err
+	}
+
+	diags := schema.Validate(prismaSchema)
+	prismaSchema = prismaSchema.WithoutIgnored()
+	names, namingDiags := newGoNames(prismaSchema, newGoNamer(cfg.Naming))
+	diags = append(diags, namingDiags...)
+	entitiesOpts := EntitiesOptions{}.withConfig(cfg.Entities)
+	diags = append(diags, checkStructNames(prismaSchema, names, cfg, entitiesOpts)...)
+	diags = append(diags, checkOneofValues(prismaSchema, entitiesOpts)...)
+	diags = append(diags, checkTableNames(prismaSchema, names)...)
+
+	return diags.Sorted(), nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    string
+		config string
+		want   []string
+	}{
+		{
+			name: "valid schema",
+			src: `model User {
+  id Int @id
+}`,
+		},
+		{
+			name: "syntax error",
+			src: `model User {
+  id Int @id(
+}`,
+			want: []string{
+				"schema.prisma:3:1: error: unexpected '}', expected a value for field id in model User",
+			},
+		},
+		{
+			name: "schema and Go identifier errors",
+			src: `model NullRole {
+  id   Int @id
+  role Rol
+}
+
+enum Role {
+  A
+}
+
+model Log {
+  message String
+}`,
+			want: []string{
+				"schema.prisma:1:1: error: model NullRole generates Go identifier NullRole in the entities package, which collides with enum Role (declared at schema.prisma:6:1)",
+				"schema.prisma:3:8: error: unknown type Rol for field role in model NullRole",
+				"schema.prisma:10:1: warning: model Log has no @id, @@id, @unique or @@unique, rows cannot be identified",
+			},
+		},
+		{
+			name: "enum value without oneof rule",
+			src: `model User {
+  id   Int  @id
+  mood Mood
+}
+
+enum Mood {
+  HAPPY
+  CANT  @map("can't")
+}`,
+			config: "entities:\n  tags: [validate]\n",
+			want: []string{
+				`schema.prisma:8:3: warning: value "can't" of enum Mood cannot be expressed in the oneof rule of validator, so the validate tags of its fields have no oneof rule`,
+			},
+		},
+		{
+			name: "Go identifier collisions",
+			src: `model User {
+  id     Int  @id
+  skuId  Int
+  sku_id Int
+  role   Role
+}
+
+enum Role {
+  ADMIN
+  admin
+}`,
+			config: "naming:\n  initialisms: [SKU]\n",
+			want: []string{
+				"schema.prisma:4:3: warning: field User.sku_id generates Go identifier SKUID, like field User.skuId (declared at schema.prisma:3:3), so it is named SKUID2, set @go.name to choose another name",
+				"schema.prisma:10:3: error: enum value Role.admin generates Go identifier RoleAdmin in the entities package, which collides with enum value Role.ADMIN (declared at schema.prisma:9:3)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := map[string][]byte{"schema.prisma": []byte(tt.src)}
+			configPath := ""
+			if tt.config != "" {
+				files["prisma-go-tools.yaml"] = []byte(tt.config)
+				configPath = filepath.Join(dir, "prisma-go-tools.yaml")
+			}
+			writeFiles(t, dir, files)
+
+			diags, err := ValidateSchema(filepath.Join(dir, "schema.prisma"), configPath)
+			if err != nil {
+				t.Fatalf("ValidateSchema() error = %v", err)
+			}
+			got := strings.ReplaceAll(diags.Error(), dir+string(filepath.Separator), "")
+			if want := strings.Join(tt.want, "\n"); got != want {
+				t.Errorf("ValidateSchema() =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}